@@ -66,6 +66,12 @@ type Config = v1.Config
 // Plugin defines the latest plugin config
 type Plugin = v1.Plugin
 
+// FaucetLedger defines the faucet ledger config.
+type FaucetLedger = config.FaucetLedger
+
+// RateLimit defines the faucet rate limit config.
+type RateLimit = config.RateLimit
+
 // DefaultConfig returns a config for the latest version initialized with default values.
 func DefaultConfig() *Config {
 	return v1.DefaultConfig()
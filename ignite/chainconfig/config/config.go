@@ -134,6 +134,70 @@ type Faucet struct {
 
 	// Port number for faucet server to listen at.
 	Port int `yaml:"port,omitempty"`
+
+	// Ledger configures where the faucet keeps track of the transferred coins.
+	Ledger FaucetLedger `yaml:"ledger,omitempty"`
+
+	// IPRateLimit limits the transfer requests that a single IP address can make.
+	IPRateLimit RateLimit `yaml:"ip_rate_limit,omitempty"`
+
+	// GlobalRateLimit limits the transfer requests served by the faucet.
+	GlobalRateLimit RateLimit `yaml:"global_rate_limit,omitempty"`
+
+	// Challenge configures the proof-of-work challenge required to request tokens.
+	Challenge FaucetChallenge `yaml:"challenge,omitempty"`
+
+	// Batch configures sending many transfer requests in a single transaction.
+	Batch FaucetBatch `yaml:"batch,omitempty"`
+
+	// LowBalance holds the coin amounts below which the faucet balance is reported as low.
+	LowBalance []string `yaml:"low_balance,omitempty"`
+
+	// AdminToken is the bearer token required to access the faucet admin endpoints.
+	// Admin endpoints are disabled when it's empty.
+	AdminToken string `yaml:"admin_token,omitempty"`
+}
+
+// FaucetBatch configures the batching of the faucet transfer requests.
+type FaucetBatch struct {
+	// Window is the time to collect transfer requests before sending them, e.g. "2s".
+	// Batching is disabled when it's empty.
+	Window string `yaml:"window,omitempty"`
+
+	// MaxSize is the max. number of transfers sent in a single transaction.
+	MaxSize int `yaml:"max_size,omitempty"`
+}
+
+// RateLimit configures a token bucket requests limit.
+// The limit is disabled when the number of requests is zero.
+type RateLimit struct {
+	// Requests is the max. number of requests allowed within the period.
+	Requests int `yaml:"requests,omitempty"`
+
+	// Period is the time it takes to restore all the allowed requests, e.g. "1m".
+	Period string `yaml:"period,omitempty"`
+}
+
+// FaucetChallenge configures the hashcash-style proof-of-work challenge that
+// clients must solve before requesting tokens.
+type FaucetChallenge struct {
+	// Difficulty is the number of leading zero bits required in the solution hash.
+	// Challenges are disabled when it's zero.
+	Difficulty uint `yaml:"difficulty,omitempty"`
+
+	// TTL is the time that a challenge can be solved in, e.g. "5m".
+	TTL string `yaml:"ttl,omitempty"`
+}
+
+// FaucetLedger configures the faucet ledger used to enforce the max. amount limits.
+type FaucetLedger struct {
+	// Backend is the name of the ledger backend, either "embedded" or "events".
+	// The embedded backend is used by default.
+	Backend string `yaml:"backend,omitempty"`
+
+	// Path is the path to the database file used by the embedded backend.
+	// By default the file is created inside Ignite's config directory.
+	Path string `yaml:"path,omitempty"`
 }
 
 // Init overwrites sdk configurations with given values.
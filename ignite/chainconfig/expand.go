@@ -0,0 +1,161 @@
+package chainconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// fileVariablePrefix is the prefix of the variables that reference files.
+const fileVariablePrefix = "file:"
+
+// variableRe matches variable references like "${NAME}" or "${file:path}".
+// References can be escaped with an extra "$", like "$${NAME}".
+var variableRe = regexp.MustCompile(`\$?\$\{([^}]*)\}`)
+
+// ExpandVariables replaces the references to environment variables and files
+// in the string values of a config.
+//
+// Environment variables are referenced as "${NAME}" and files as
+// "${file:path}", where relative paths are relative to the given directory,
+// which is usually the directory of the config file. The trailing new lines
+// of the files are removed. This allows keeping secrets like mnemonics out
+// of the config file:
+//
+//	mnemonic: ${file:secrets/alice.txt}
+//
+// An error is returned when an environment variable is not defined or a file
+// can't be read. References can be escaped with an extra "$", like "$${NAME}".
+func ExpandVariables(c *Config, dir string) error {
+	_, err := expandVariables(c, dir)
+	return err
+}
+
+// expandVariables expands the variable references of a config and returns
+// the paths of the files referenced in its values.
+func expandVariables(c *Config, dir string) ([]string, error) {
+	e := &expander{dir: dir}
+	err := e.expandValue(reflect.ValueOf(c).Elem())
+	return e.files, err
+}
+
+// hasVariables checks if a config value contains variable references.
+func hasVariables(s string) bool {
+	return variableRe.MatchString(s)
+}
+
+type expander struct {
+	dir string
+
+	// files holds the paths of the referenced files.
+	files []string
+}
+
+func (e *expander) expandValue(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.String:
+		s, err := e.expand(v.String())
+		if err != nil {
+			return err
+		}
+
+		v.SetString(s)
+	case reflect.Ptr:
+		if !v.IsNil() {
+			return e.expandValue(v.Elem())
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		// The values inside interfaces are not settable so they are
+		// expanded in a copy that then replaces the original value
+		elem := reflect.New(v.Elem().Type()).Elem()
+		elem.Set(v.Elem())
+		if err := e.expandValue(elem); err != nil {
+			return err
+		}
+
+		v.Set(elem)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.CanSet() {
+				if err := e.expandValue(f); err != nil {
+					return err
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := e.expandValue(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			value := reflect.New(iter.Value().Type()).Elem()
+			value.Set(iter.Value())
+			if err := e.expandValue(value); err != nil {
+				return err
+			}
+
+			v.SetMapIndex(iter.Key(), value)
+		}
+	}
+
+	return nil
+}
+
+func (e *expander) expand(s string) (string, error) {
+	var err error
+	s = variableRe.ReplaceAllStringFunc(s, func(ref string) string {
+		if err != nil {
+			return ref
+		}
+
+		// Escaped references are kept without the extra "$"
+		if strings.HasPrefix(ref, "$$") {
+			return ref[1:]
+		}
+
+		name := variableRe.FindStringSubmatch(ref)[1]
+		if path := strings.TrimPrefix(name, fileVariablePrefix); path != name {
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(e.dir, path)
+			}
+			e.addFile(path)
+
+			var bz []byte
+			if bz, err = os.ReadFile(path); err != nil {
+				err = fmt.Errorf("error reading config variable file: %w", err)
+				return ref
+			}
+
+			return strings.TrimRight(string(bz), "\r\n")
+		}
+
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			err = fmt.Errorf("environment variable %q referenced in config is not defined", name)
+			return ref
+		}
+
+		return value
+	})
+
+	return s, err
+}
+
+func (e *expander) addFile(path string) {
+	for _, f := range e.files {
+		if f == path {
+			return
+		}
+	}
+	e.files = append(e.files, path)
+}
@@ -0,0 +1,139 @@
+package chainconfig_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/chainconfig/config"
+)
+
+func TestExpandVariables(t *testing.T) {
+	// Arrange
+	t.Setenv("ALICE_COINS", "100token")
+	t.Setenv("CHAIN_ID", "mars-1")
+	configPath := writeConfigFiles(t, map[string]string{
+		"config.yml": `version: 1
+accounts:
+- name: alice
+  coins: ["${ALICE_COINS}", "5stake"]
+  mnemonic: ${file:alice.txt}
+genesis:
+  chain_id: ${CHAIN_ID}
+  app_state:
+    denoms: ["$${CHAIN_ID}"]
+validators:
+- name: alice
+  bonded: 10token
+`,
+		"alice.txt": "wise cover hint\n",
+	})
+
+	// Act
+	cfg, err := chainconfig.ParseFile(configPath)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{"100token", "5stake"}, cfg.Accounts[0].Coins)
+	require.Equal(t, "wise cover hint", cfg.Accounts[0].Mnemonic)
+	require.Equal(t, "mars-1", cfg.Genesis["chain_id"])
+	require.Equal(t, map[string]interface{}{
+		"denoms": []interface{}{"${CHAIN_ID}"},
+	}, cfg.Genesis["app_state"])
+}
+
+func TestParseFileUnexpanded(t *testing.T) {
+	// Arrange
+	configPath := writeConfigFiles(t, map[string]string{
+		"config.yml": `version: 1
+accounts:
+- name: alice
+  coins: ["100token"]
+  mnemonic: ${IGNITE_TEST_UNDEFINED}
+build:
+  proto:
+    path: proto
+validators:
+- name: alice
+  bonded: 10token
+`,
+	})
+
+	// Act
+	_, expandErr := chainconfig.ParseFile(configPath)
+	cfg, err := chainconfig.ParseFileUnexpanded(configPath)
+
+	// Assert
+	require.Error(t, expandErr)
+	require.NoError(t, err)
+	require.Equal(t, "${IGNITE_TEST_UNDEFINED}", cfg.Accounts[0].Mnemonic)
+	require.Equal(t, "proto", cfg.Build.Proto.Path)
+}
+
+func TestExpandVariablesErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expectedError string
+	}{
+		{
+			name:          "fail: undefined environment variable",
+			value:         "${IGNITE_TEST_UNDEFINED}",
+			expectedError: `environment variable "IGNITE_TEST_UNDEFINED" referenced in config is not defined`,
+		},
+		{
+			name:          "fail: missing file",
+			value:         "${file:missing.txt}",
+			expectedError: "error reading config variable file: open .*missing.txt: no such file or directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := chainconfig.DefaultConfig()
+			cfg.Accounts = append(cfg.Accounts, config.Account{Name: "alice", Mnemonic: tt.value})
+
+			err := chainconfig.ExpandVariables(cfg, t.TempDir())
+
+			require.Error(t, err)
+			require.Regexp(t, tt.expectedError, err.Error())
+		})
+	}
+}
+
+func TestReferencedFiles(t *testing.T) {
+	// Arrange
+	configPath := writeConfigFiles(t, map[string]string{
+		"config.yml": `version: 1
+accounts:
+- name: alice
+  coins: ["100token"]
+  mnemonic: ${file:alice.txt}
+- name: bob
+  coins: ["100token"]
+  mnemonic: $${file:escaped.txt}
+validators:
+- name: alice
+  bonded: 10token
+`,
+		"config.ci.yml": `accounts:
+- name: alice
+  coins: ["100token"]
+  mnemonic: ${file:alice.txt}
+- name: carol
+  coins: ["100token"]
+  mnemonic: ${file:carol.txt}
+`,
+		"alice.txt": "wise cover hint\n",
+		"carol.txt": "tower dune echo\n",
+	})
+	dir := filepath.Dir(configPath)
+
+	// Act
+	files, err := chainconfig.ReferencedFiles(configPath, "ci")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "alice.txt"), filepath.Join(dir, "carol.txt")}, files)
+}
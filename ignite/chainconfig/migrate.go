@@ -0,0 +1,162 @@
+package chainconfig
+
+import (
+	"bytes"
+
+	yamlv2 "gopkg.in/yaml.v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Migrate converts the content of a config file to the latest version.
+// Unlike MigrateLatest, the comments, the order of the keys and the style of
+// the values of the current config are kept where possible.
+func Migrate(current []byte) ([]byte, error) {
+	cfg, err := Parse(bytes.NewReader(current))
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := yamlv2.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest, doc yaml.Node
+	if err := yaml.Unmarshal(bz, &latest); err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(current, &doc); err != nil {
+		return nil, err
+	}
+
+	// Empty files don't have a document node
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		doc = latest
+	} else {
+		doc.Content[0] = mergeNodes(doc.Content[0], latest.Content[0])
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// mergeNodes returns the latest node with the comments, the key order and the
+// style of the current node, when both nodes are of the same kind.
+func mergeNodes(current, latest *yaml.Node) *yaml.Node {
+	if current.Kind != latest.Kind {
+		return latest
+	}
+
+	merged := *latest
+	merged.HeadComment = current.HeadComment
+	merged.LineComment = current.LineComment
+	merged.FootComment = current.FootComment
+
+	switch latest.Kind {
+	case yaml.ScalarNode:
+		if current.Value == latest.Value {
+			merged.Style = current.Style
+		}
+	case yaml.SequenceNode:
+		merged.Style = current.Style
+		merged.Content = make([]*yaml.Node, len(latest.Content))
+		for i, n := range latest.Content {
+			if i < len(current.Content) {
+				n = mergeNodes(current.Content[i], n)
+			}
+
+			merged.Content[i] = n
+		}
+	case yaml.MappingNode:
+		merged.Style = current.Style
+		merged.Content = mergeMappings(current.Content, latest.Content)
+	}
+
+	return &merged
+}
+
+// mergeMappings merges the key and value nodes of two mappings.
+// The keys of the current mapping that are also in the latest one keep their
+// order and the keys that only exist in the latest one are added at the end,
+// except for the first key of the latest mapping which is added at the
+// beginning, like the config version. Keys with empty values are not added.
+// The keys that only exist in the current mapping are removed.
+func mergeMappings(current, latest []*yaml.Node) []*yaml.Node {
+	latestValues := make(map[string]*yaml.Node)
+	for i := 0; i+1 < len(latest); i += 2 {
+		latestValues[latest[i].Value] = latest[i+1]
+	}
+
+	var merged []*yaml.Node
+	currentKeys := make(map[string]bool)
+	for i := 0; i+1 < len(current); i += 2 {
+		key := current[i]
+		currentKeys[key.Value] = true
+
+		if value, ok := latestValues[key.Value]; ok {
+			merged = append(merged, key, mergeNodes(current[i+1], value))
+		}
+	}
+
+	for i := 0; i+1 < len(latest); i += 2 {
+		key, value := *latest[i], pruneNode(latest[i+1])
+		if currentKeys[key.Value] || value == nil {
+			continue
+		}
+
+		if i > 0 || len(merged) == 0 {
+			merged = append(merged, &key, value)
+			continue
+		}
+
+		// The comment of the first key usually describes the whole mapping
+		// so it must remain at the beginning when a key is added before.
+		first := *merged[0]
+		key.HeadComment, first.HeadComment = first.HeadComment, ""
+		merged[0] = &first
+		merged = append([]*yaml.Node{&key, value}, merged...)
+	}
+
+	return merged
+}
+
+// pruneNode returns a copy of a node without the mapping keys that have
+// empty values, or nil when the node is empty.
+func pruneNode(n *yaml.Node) *yaml.Node {
+	pruned := *n
+	switch n.Kind {
+	case yaml.ScalarNode:
+		if n.Tag == "!!null" {
+			return nil
+		}
+	case yaml.SequenceNode:
+		if len(n.Content) == 0 {
+			return nil
+		}
+	case yaml.MappingNode:
+		pruned.Content = nil
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			if value := pruneNode(n.Content[i+1]); value != nil {
+				pruned.Content = append(pruned.Content, n.Content[i], value)
+			}
+		}
+
+		if len(pruned.Content) == 0 {
+			return nil
+		}
+	}
+
+	return &pruned
+}
@@ -0,0 +1,75 @@
+package chainconfig_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/chainconfig/testdata"
+)
+
+func TestMigrate(t *testing.T) {
+	// Arrange
+	current := []byte(`# Chain config
+accounts:
+  # Validator account
+  - name: alice
+    coins: ["100token"] # initial coins
+validator:
+  name: alice
+  staked: "10token"
+build:
+  binary: "marsd"
+init:
+  home: "$HOME/.mars"
+`)
+	want := `# Chain config
+version: 1
+accounts:
+  # Validator account
+  - name: alice
+    coins: ["100token"] # initial coins
+build:
+  binary: "marsd"
+  proto:
+    path: proto
+    third_party_paths:
+      - third_party/proto
+      - proto_vendor
+faucet:
+  host: 0.0.0.0:4500
+validators:
+  - name: alice
+    bonded: 10token
+    home: $HOME/.mars
+`
+
+	// Act
+	latest, err := chainconfig.Migrate(current)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, want, string(latest))
+}
+
+func TestMigrateWithPreviousVersion(t *testing.T) {
+	// Arrange
+	current := testdata.Versions[chainconfig.LatestVersion-1]
+
+	// Act
+	latest, err := chainconfig.Migrate(current)
+
+	// Assert
+	require.NoError(t, err)
+
+	// Assert: The migrated config must be the latest version of the config
+	cfg, err := chainconfig.Parse(bytes.NewReader(latest))
+	require.NoError(t, err)
+	require.Equal(t, testdata.GetLatestConfig(t), cfg)
+
+	version, err := chainconfig.ReadConfigVersion(bytes.NewReader(latest))
+	require.NoError(t, err)
+	require.Equal(t, chainconfig.LatestVersion, version)
+}
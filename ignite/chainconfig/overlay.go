@@ -0,0 +1,67 @@
+package chainconfig
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/imdario/mergo"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/chainconfig/config"
+)
+
+// OverlayPath returns the path of a config overlay file.
+// Overlay files are located next to the config file and their name includes
+// the overlay name before the extension, for example "config.ci.yml".
+func OverlayPath(configPath, name string) string {
+	ext := filepath.Ext(configPath)
+	return fmt.Sprintf("%s.%s%s", strings.TrimSuffix(configPath, ext), name, ext)
+}
+
+// applyOverlayFile merges the values of an overlay file on top of a config.
+// Overlay values replace the config values, except for maps that are merged,
+// so lists like the accounts or validators are replaced as a whole.
+func applyOverlayFile(c config.Converter, path string) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading config overlay: %w", err)
+	}
+
+	if err := applyOverlay(c, bz); err != nil {
+		return fmt.Errorf("error applying config overlay %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func applyOverlay(c config.Converter, overlay []byte) error {
+	// The version can be omitted in overlays, in which case the overlay
+	// must use the same version as the config.
+	var v struct {
+		Version *config.Version `yaml:"version"`
+	}
+
+	if err := yaml.Unmarshal(overlay, &v); err != nil {
+		return err
+	}
+
+	if v.Version != nil && *v.Version != c.GetVersion() {
+		return fmt.Errorf("overlay version %s doesn't match config version %s", *v.Version, c.GetVersion())
+	}
+
+	o, err := decodeConfig(bytes.NewReader(overlay), c.GetVersion())
+	if err != nil {
+		// Empty overlays don't change the config
+		if err == io.EOF {
+			return nil
+		}
+
+		return err
+	}
+
+	return mergo.Merge(c, o, mergo.WithOverride)
+}
@@ -0,0 +1,148 @@
+package chainconfig_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+const overlayBaseConfig = `version: 1
+accounts:
+- name: alice
+  coins: ["100token"]
+faucet:
+  name: alice
+  coins: ["5token"]
+genesis:
+  chain_id: mars-1
+  app_state:
+    staking:
+      params:
+        bond_denom: token
+validators:
+- name: alice
+  bonded: 10token
+`
+
+func TestOverlayPath(t *testing.T) {
+	require.Equal(t, "/mars/config.ci.yml", chainconfig.OverlayPath("/mars/config.yml", "ci"))
+	require.Equal(t, "config.testnet.yaml", chainconfig.OverlayPath("config.yaml", "testnet"))
+}
+
+func TestParseFileWithOverlays(t *testing.T) {
+	// Arrange
+	configPath := writeConfigFiles(t, map[string]string{
+		"config.yml": overlayBaseConfig,
+		"config.ci.yml": `accounts:
+- name: bob
+  coins: ["1token"]
+faucet:
+  name: bob
+genesis:
+  chain_id: mars-ci
+`,
+		"config.slow.yml": `genesis:
+  app_state:
+    staking:
+      params:
+        unbonding_time: 1s
+`,
+	})
+
+	// Act
+	cfg, err := chainconfig.ParseFile(configPath, "ci", "slow")
+
+	// Assert
+	require.NoError(t, err)
+
+	// Assert: Lists are replaced by the overlays
+	require.Len(t, cfg.Accounts, 1)
+	require.Equal(t, "bob", cfg.Accounts[0].Name)
+
+	// Assert: Values not defined in the overlays are kept
+	require.Equal(t, "bob", *cfg.Faucet.Name)
+	require.Equal(t, []string{"5token"}, cfg.Faucet.Coins)
+	require.Equal(t, "alice", cfg.Validators[0].Name)
+
+	// Assert: Maps are merged
+	require.Equal(t, "mars-ci", cfg.Genesis["chain_id"])
+	require.Equal(t, map[string]interface{}{
+		"staking": map[string]interface{}{
+			"params": map[string]interface{}{
+				"bond_denom":     "token",
+				"unbonding_time": "1s",
+			},
+		},
+	}, cfg.Genesis["app_state"])
+}
+
+func TestParseFileWithOverlayErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		overlay       string
+		expectedError string
+	}{
+		{
+			name:          "fail: missing overlay",
+			expectedError: "error reading config overlay: open .*config.ci.yml: no such file or directory",
+		},
+		{
+			name:          "fail: version mismatch",
+			overlay:       "version: 0\n",
+			expectedError: "error applying config overlay .*config.ci.yml: overlay version v0 doesn't match config version v1",
+		},
+		{
+			name:          "fail: invalid overlay",
+			overlay:       "accounts: foo\n",
+			expectedError: "error applying config overlay .*config.ci.yml: yaml: unmarshal errors:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{"config.yml": overlayBaseConfig}
+			if tt.overlay != "" {
+				files["config.ci.yml"] = tt.overlay
+			}
+
+			configPath := writeConfigFiles(t, files)
+
+			_, err := chainconfig.ParseFile(configPath, "ci")
+
+			require.Error(t, err)
+			require.Regexp(t, tt.expectedError, err.Error())
+		})
+	}
+}
+
+func TestParseFileWithEmptyOverlay(t *testing.T) {
+	// Arrange
+	configPath := writeConfigFiles(t, map[string]string{
+		"config.yml":    overlayBaseConfig,
+		"config.ci.yml": "",
+	})
+
+	// Act
+	cfg, err := chainconfig.ParseFile(configPath, "ci")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "alice", cfg.Accounts[0].Name)
+}
+
+// writeConfigFiles writes config files into a temporary directory
+// and returns the path of the "config.yml" file.
+func writeConfigFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644)
+		require.NoError(t, err)
+	}
+
+	return filepath.Join(dir, "config.yml")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"gopkg.in/yaml.v2"
@@ -36,7 +37,7 @@ func ParseNetwork(configFile io.Reader) (*Config, error) {
 	return cfg, validateNetworkConfig(cfg)
 }
 
-func parse(configFile io.Reader) (*Config, error) {
+func parse(configFile io.Reader, overlayPaths ...string) (*Config, error) {
 	var buf bytes.Buffer
 
 	// Read the config file version first to know how to decode it
@@ -52,6 +53,14 @@ func parse(configFile io.Reader) (*Config, error) {
 		return DefaultConfig(), err
 	}
 
+	// Overlays are merged before assigning the default values
+	// so they can't be overwritten by the defaults
+	for _, path := range overlayPaths {
+		if err := applyOverlayFile(c, path); err != nil {
+			return DefaultConfig(), err
+		}
+	}
+
 	// Make sure that the empty fields contain default values
 	// after reading the config from the YAML file
 	if err = c.SetDefaults(); err != nil {
@@ -68,15 +77,60 @@ func parse(configFile io.Reader) (*Config, error) {
 }
 
 // ParseFile parses a config from a file path.
-func ParseFile(path string) (*Config, error) {
+// The overlay files of the given names are merged on top of the config, for
+// example the "ci" overlay of "config.yml" is read from "config.ci.yml".
+// The references to environment variables and files in the config values
+// are expanded, see ExpandVariables.
+func ParseFile(path string, overlays ...string) (*Config, error) {
+	cfg, _, err := parseFile(path, true, overlays...)
+	return cfg, err
+}
+
+// ParseFileUnexpanded parses a config from a file path like ParseFile, but the
+// references to environment variables and files in the config values are kept
+// as is, so the config can be read without the environment of the chain.
+func ParseFileUnexpanded(path string, overlays ...string) (*Config, error) {
+	cfg, _, err := parseFile(path, false, overlays...)
+	return cfg, err
+}
+
+// ReferencedFiles returns the paths of the files referenced as "${file:path}"
+// in the values of a config file and its overlays.
+func ReferencedFiles(path string, overlays ...string) ([]string, error) {
+	_, files, err := parseFile(path, true, overlays...)
+	return files, err
+}
+
+// parseFile parses a config from a file path and returns the paths of the
+// files referenced in its values when they are expanded.
+func parseFile(path string, expand bool, overlays ...string) (*Config, []string, error) {
 	file, err := os.Open(path)
 	if err != nil {
-		return DefaultConfig(), err
+		return DefaultConfig(), nil, err
 	}
 
 	defer file.Close()
 
-	return Parse(file)
+	overlayPaths := make([]string, len(overlays))
+	for i, name := range overlays {
+		overlayPaths[i] = OverlayPath(path, name)
+	}
+
+	cfg, err := parse(file, overlayPaths...)
+	if err != nil {
+		return cfg, nil, err
+	}
+
+	if !expand {
+		return cfg, nil, validateConfig(cfg)
+	}
+
+	files, err := expandVariables(cfg, filepath.Dir(path))
+	if err != nil {
+		return DefaultConfig(), files, err
+	}
+
+	return cfg, files, validateConfig(cfg)
 }
 
 // ParseNetworkFile parses a config for Ignite Network genesis from a file path.
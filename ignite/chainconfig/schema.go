@@ -0,0 +1,93 @@
+package chainconfig
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// JSONSchemaURI is the JSON Schema version of the generated config schema.
+const JSONSchemaURI = "http://json-schema.org/draft-07/schema#"
+
+// schema defines a JSON Schema document or sub schema.
+type schema map[string]interface{}
+
+// JSONSchema returns the JSON Schema of the latest config version.
+// The schema is generated from the YAML tags of the config types, so it can
+// be used by editors to autocomplete and validate config files.
+func JSONSchema() ([]byte, error) {
+	s := schemaOf(reflect.TypeOf(Config{}))
+	s["$schema"] = JSONSchemaURI
+	s["title"] = "Ignite blockchain config"
+
+	// Only the latest config version is described by the schema
+	if props, ok := s["properties"].(schema); ok {
+		props["version"] = schema{"type": "integer", "const": LatestVersion}
+	}
+
+	return json.MarshalIndent(s, "", "  ")
+}
+
+func schemaOf(t reflect.Type) schema {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return schemaOf(t.Elem())
+	case reflect.Struct:
+		props := schema{}
+		addStructProperties(props, t)
+		return schema{
+			"type":                 "object",
+			"properties":           props,
+			"additionalProperties": false,
+		}
+	case reflect.Map:
+		// Maps with interface values like the ones used to overwrite
+		// the app configs accept any value.
+		if t.Elem().Kind() == reflect.Interface {
+			return schema{"type": "object"}
+		}
+		return schema{
+			"type":                 "object",
+			"additionalProperties": schemaOf(t.Elem()),
+		}
+	case reflect.Slice, reflect.Array:
+		return schema{
+			"type":  "array",
+			"items": schemaOf(t.Elem()),
+		}
+	case reflect.String:
+		return schema{"type": "string"}
+	case reflect.Bool:
+		return schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return schema{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return schema{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return schema{"type": "number"}
+	}
+
+	return schema{}
+}
+
+func addStructProperties(props schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "-" || (!field.IsExported() && !field.Anonymous) {
+			continue
+		}
+
+		// Inlined structs share the properties of the parent
+		if strings.Contains(opts, "inline") {
+			addStructProperties(props, field.Type)
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+
+		props[name] = schemaOf(field.Type)
+	}
+}
@@ -0,0 +1,37 @@
+package chainconfig_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+func TestJSONSchema(t *testing.T) {
+	// Act
+	bz, err := chainconfig.JSONSchema()
+
+	// Assert
+	require.NoError(t, err)
+
+	var schema struct {
+		Properties map[string]struct {
+			Type  string `json:"type"`
+			Const int    `json:"const"`
+			Items struct {
+				Properties map[string]interface{} `json:"properties"`
+			} `json:"items"`
+		} `json:"properties"`
+		AdditionalProperties bool `json:"additionalProperties"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &schema))
+	require.False(t, schema.AdditionalProperties)
+	require.EqualValues(t, chainconfig.LatestVersion, schema.Properties["version"].Const)
+	require.Equal(t, "array", schema.Properties["validators"].Type)
+	require.Contains(t, schema.Properties["validators"].Items.Properties, "keyring-backend")
+	require.Contains(t, schema.Properties["accounts"].Items.Properties, "rpc_address")
+	require.Contains(t, schema.Properties, "faucet")
+	require.Contains(t, schema.Properties, "plugins")
+}
@@ -45,7 +45,7 @@ type Plugin struct {
 	// path: github.com/foo/bar/plugin1@v42
 	Path string `yaml:"path"`
 	// With holds arguments passed to the plugin interface
-	With map[string]string `yaml:"with"`
+	With map[string]string `yaml:"with,omitempty"`
 }
 
 func (c *Config) SetDefaults() error {
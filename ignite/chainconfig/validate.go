@@ -0,0 +1,334 @@
+package chainconfig
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	goyaml "github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
+	"gopkg.in/yaml.v2"
+
+	v1 "github.com/ignite/cli/ignite/chainconfig/v1"
+)
+
+var (
+	yamlErrorRe    = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+	unknownFieldRe = regexp.MustCompile(`^field (\S+) not found in type \S+$`)
+)
+
+// Issue is a problem found while validating a config file.
+type Issue struct {
+	// File is the path of the config file.
+	File string
+
+	// Line is the line of the config file where the problem is found.
+	// It is zero when the problem is not related to a specific line.
+	Line int
+
+	// Message describes the problem.
+	Message string
+}
+
+func (i Issue) String() string {
+	switch {
+	case i.File != "" && i.Line > 0:
+		return fmt.Sprintf("%s:%d: %s", i.File, i.Line, i.Message)
+	case i.File != "":
+		return fmt.Sprintf("%s: %s", i.File, i.Message)
+	case i.Line > 0:
+		return fmt.Sprintf("line %d: %s", i.Line, i.Message)
+	}
+
+	return i.Message
+}
+
+// Validate strictly validates a config file of the latest version and returns
+// the problems found in it.
+// Unlike Parse, unknown keys are reported, as well as invalid coins and
+// addresses, and validator servers that use the same port.
+// Values with variable references are not checked because they are only
+// known when the config is parsed with ParseFile.
+// A VersionError is returned when the config is not the latest version.
+func Validate(configFile io.Reader) ([]Issue, error) {
+	data, err := io.ReadAll(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := ReadConfigVersion(bytes.NewReader(data))
+	if err != nil {
+		if issues, ok := yamlIssues(err); ok {
+			return issues, nil
+		}
+		return nil, err
+	}
+
+	if version != LatestVersion {
+		return nil, VersionError{version}
+	}
+
+	v := configValidator{}
+	if v.file, err = parser.ParseBytes(data, 0); err != nil {
+		return nil, err
+	}
+
+	// Decoding errors are reported but the validation continues
+	// because the rest of the config is still decoded
+	var cfg Config
+	if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+		issues, ok := yamlIssues(err)
+		if !ok {
+			return nil, err
+		}
+		v.issues = issues
+	}
+
+	v.validate(&cfg)
+
+	sort.SliceStable(v.issues, func(i, j int) bool {
+		return v.issues[i].Line < v.issues[j].Line
+	})
+
+	return v.issues, nil
+}
+
+// ValidateFile strictly validates a config file from a file path.
+func ValidateFile(path string) ([]Issue, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	issues, err := Validate(file)
+	for i := range issues {
+		issues[i].File = path
+	}
+
+	return issues, err
+}
+
+// yamlIssues converts YAML decoding errors to issues.
+func yamlIssues(err error) ([]Issue, bool) {
+	var messages []string
+	if typeErr, ok := err.(*yaml.TypeError); ok {
+		messages = typeErr.Errors
+	} else {
+		messages = []string{err.Error()}
+	}
+
+	var issues []Issue
+	for _, m := range messages {
+		match := yamlErrorRe.FindStringSubmatch(m)
+		if match == nil {
+			return nil, false
+		}
+
+		line, _ := strconv.Atoi(match[1])
+		msg := match[2]
+		if field := unknownFieldRe.FindStringSubmatch(msg); field != nil {
+			msg = fmt.Sprintf("unknown key %q", field[1])
+		}
+
+		issues = append(issues, Issue{Line: line, Message: msg})
+	}
+
+	return issues, true
+}
+
+type configValidator struct {
+	file   *ast.File
+	issues []Issue
+}
+
+// report adds an issue for the value found in a YAML path.
+// The line of the closest parent is used when the value is not
+// defined in the config file.
+func (v *configValidator) report(path, format string, args ...interface{}) {
+	v.issues = append(v.issues, Issue{
+		Line:    v.line(path),
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *configValidator) line(path string) int {
+	for path != "$" {
+		if p, err := goyaml.PathString(path); err == nil {
+			if node, err := p.FilterFile(v.file); err == nil && node != nil {
+				return node.GetToken().Position.Line
+			}
+		}
+
+		// Try again with the parent path
+		path = path[:strings.LastIndexAny(path, ".[")]
+	}
+
+	return 0
+}
+
+func (v *configValidator) validate(cfg *Config) {
+	if len(cfg.Accounts) == 0 {
+		v.report("$.accounts", "at least one account is required")
+	}
+
+	for i, account := range cfg.Accounts {
+		path := fmt.Sprintf("$.accounts[%d]", i)
+		if account.Name == "" {
+			v.report(path, "account 'name' is required")
+		}
+
+		v.validateCoins(path+".coins", account.Coins)
+
+		if account.Address != "" && !hasVariables(account.Address) {
+			if _, _, err := bech32.DecodeAndConvert(account.Address); err != nil {
+				v.report(path+".address", "invalid address %q: %s", account.Address, err)
+			}
+		}
+	}
+
+	v.validateCoins("$.faucet.coins", cfg.Faucet.Coins)
+	v.validateCoins("$.faucet.coins_max", cfg.Faucet.CoinsMax)
+	v.validateCoins("$.faucet.low_balance", cfg.Faucet.LowBalance)
+
+	if cfg.Faucet.Host != "" && !hasVariables(cfg.Faucet.Host) {
+		if _, err := addressPort(cfg.Faucet.Host); err != nil {
+			v.report("$.faucet.host", "invalid address %q: %s", cfg.Faucet.Host, err)
+		}
+	}
+
+	if len(cfg.Validators) == 0 {
+		v.report("$.validators", "at least one validator is required")
+	}
+
+	for i, validator := range cfg.Validators {
+		path := fmt.Sprintf("$.validators[%d]", i)
+		if validator.Name == "" {
+			v.report(path, "validator 'name' is required")
+		}
+
+		if validator.Bonded == "" {
+			v.report(path, "validator 'bonded' is required")
+		} else {
+			v.validateCoin(path+".bonded", validator.Bonded)
+		}
+	}
+
+	v.validateServers(cfg)
+}
+
+func (v *configValidator) validateCoins(path string, coins []string) {
+	for i, coin := range coins {
+		v.validateCoin(fmt.Sprintf("%s[%d]", path, i), coin)
+	}
+}
+
+func (v *configValidator) validateCoin(path, coin string) {
+	if hasVariables(coin) {
+		return
+	}
+
+	if _, err := sdk.ParseCoinNormalized(coin); err != nil {
+		v.report(path, "invalid coin %q: %s", coin, err)
+	}
+}
+
+// validateServers checks that the server addresses of the validators are
+// valid and that they don't use the same port.
+// The addresses are checked after assigning the default values, which
+// increment the ports of the default addresses for each validator.
+func (v *configValidator) validateServers(cfg *Config) {
+	if err := cfg.SetDefaults(); err != nil {
+		v.report("$.validators", "invalid validator servers: %s", err)
+		return
+	}
+
+	type portUser struct {
+		validator string
+		key       string
+	}
+
+	ports := make(map[int]portUser)
+	for i, validator := range cfg.Validators {
+		servers, err := validator.GetServers()
+		if err != nil {
+			v.report(fmt.Sprintf("$.validators[%d]", i), "%s", err)
+			continue
+		}
+
+		for _, s := range validatorServers(servers) {
+			if s.address == "" || hasVariables(s.address) {
+				continue
+			}
+
+			path := fmt.Sprintf("$.validators[%d].%s", i, s.key)
+			port, err := addressPort(s.address)
+			if err != nil {
+				v.report(path, "invalid address %q: %s", s.address, err)
+				continue
+			}
+
+			if u, ok := ports[port]; ok {
+				v.report(
+					path,
+					"port %d of validator %q is already used by %s of validator %q",
+					port,
+					validator.Name,
+					u.key,
+					u.validator,
+				)
+				continue
+			}
+
+			ports[port] = portUser{validator.Name, s.key}
+		}
+	}
+}
+
+type validatorServer struct {
+	key     string
+	address string
+}
+
+// validatorServers returns the server addresses of a validator along
+// with the config keys where they are defined.
+func validatorServers(s v1.Servers) []validatorServer {
+	return []validatorServer{
+		{"app.grpc.address", s.GRPC.Address},
+		{"app.grpc-web.address", s.GRPCWeb.Address},
+		{"app.api.address", s.API.Address},
+		{"config.p2p.laddr", s.P2P.Address},
+		{"config.rpc.laddr", s.RPC.Address},
+		{"config.rpc.pprof_laddr", s.RPC.PProfAddress},
+	}
+}
+
+// addressPort returns the port of a "host:port" address which can
+// optionally be prefixed with a scheme, like "tcp://0.0.0.0:26657".
+func addressPort(address string) (int, error) {
+	if _, addr, ok := strings.Cut(address, "://"); ok {
+		address = addr
+	}
+
+	_, p, err := net.SplitHostPort(address)
+	if err != nil {
+		return 0, err
+	}
+
+	port, err := strconv.Atoi(p)
+	if err != nil || port < 0 || port > 65535 {
+		return 0, fmt.Errorf("invalid port %q", p)
+	}
+
+	return port, nil
+}
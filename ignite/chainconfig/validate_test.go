@@ -0,0 +1,180 @@
+package chainconfig_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/chainconfig/testdata"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         string
+		expectedIssues []chainconfig.Issue
+	}{
+		{
+			name: "valid config",
+			config: `version: 1
+accounts:
+- name: alice
+  coins: ["100token"]
+  address: cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw
+validators:
+- name: alice
+  bonded: 10token
+- name: bob
+  bonded: 10token
+`,
+		},
+		{
+			name: "unknown keys",
+			config: `version: 1
+acounts:
+- name: alice
+validators:
+- name: alice
+  bonded: 10token
+  home: ~/.alice
+  homes: ~/.bob
+`,
+			expectedIssues: []chainconfig.Issue{
+				{Line: 0, Message: "at least one account is required"},
+				{Line: 2, Message: `unknown key "acounts"`},
+				{Line: 8, Message: `unknown key "homes"`},
+			},
+		},
+		{
+			name: "invalid coins",
+			config: `version: 1
+accounts:
+- name: alice
+  coins:
+  - 100token
+  - 100$token
+faucet:
+  coins_max: ["-1token"]
+validators:
+- name: alice
+  bonded: token
+`,
+			expectedIssues: []chainconfig.Issue{
+				{Line: 6, Message: `invalid coin "100$token": invalid decimal coin expression: 100$token`},
+				{Line: 8, Message: `invalid coin "-1token": invalid decimal coin expression: -1token`},
+				{Line: 11, Message: `invalid coin "token": invalid decimal coin expression: token`},
+			},
+		},
+		{
+			name: "invalid addresses",
+			config: `version: 1
+accounts:
+- name: alice
+  address: cosmos1foo
+faucet:
+  host: localhost
+validators:
+- name: alice
+  bonded: 10token
+  config:
+    rpc:
+      laddr: tcp://0.0.0.0:foo
+`,
+			expectedIssues: []chainconfig.Issue{
+				{Line: 4, Message: `invalid address "cosmos1foo": decoding bech32 failed: invalid separator index 6`},
+				{Line: 6, Message: `invalid address "localhost": address localhost: missing port in address`},
+				{Line: 12, Message: `invalid address "tcp://0.0.0.0:foo": invalid port "foo"`},
+			},
+		},
+		{
+			name: "validator port clash",
+			config: `version: 1
+accounts:
+- name: alice
+validators:
+- name: alice
+  bonded: 10token
+- name: bob
+  bonded: 10token
+  app:
+    api:
+      address: 0.0.0.0:9090
+`,
+			expectedIssues: []chainconfig.Issue{
+				{Line: 11, Message: `port 9090 of validator "bob" is already used by app.grpc.address of validator "alice"`},
+			},
+		},
+		{
+			name: "missing validator properties",
+			config: `version: 1
+accounts:
+- name: alice
+validators:
+- home: ~/.alice
+`,
+			expectedIssues: []chainconfig.Issue{
+				{Line: 5, Message: "validator 'name' is required"},
+				{Line: 5, Message: "validator 'bonded' is required"},
+			},
+		},
+		{
+			name:   "invalid YAML",
+			config: "version: 1\naccounts: [\n",
+			expectedIssues: []chainconfig.Issue{
+				{Line: 2, Message: "did not find expected node content"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := chainconfig.Validate(strings.NewReader(tt.config))
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedIssues, issues)
+		})
+	}
+}
+
+func TestValidateWithLatestTestdata(t *testing.T) {
+	// Arrange
+	r := bytes.NewReader(testdata.Versions[chainconfig.LatestVersion])
+
+	// Act
+	issues, err := chainconfig.Validate(r)
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, issues)
+}
+
+func TestValidateWithPreviousVersion(t *testing.T) {
+	// Arrange
+	version := chainconfig.LatestVersion - 1
+	r := bytes.NewReader(testdata.Versions[version])
+
+	// Act
+	_, err := chainconfig.Validate(r)
+
+	// Assert
+	require.ErrorIs(t, err, chainconfig.VersionError{Version: version})
+}
+
+func TestValidateFile(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "config.yml")
+	err := os.WriteFile(path, []byte("version: 1\nvalidators:\n- name: alice\n  bonded: 10token\n"), 0o644)
+	require.NoError(t, err)
+
+	// Act
+	issues, err := chainconfig.ValidateFile(path)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	require.Equal(t, path+": at least one account is required", issues[0].String())
+}
@@ -74,6 +74,13 @@ to send token from any other account that exists on chain.
 
 The "simulate" command helps you start a simulation testing process for your
 chain.
+
+The "index" command collects the transactions and events of a running chain
+into a database and keeps collecting them for each new block.
+
+The "config" commands validate the "config.yml" file, reporting unknown keys and
+invalid values, migrate it to the latest config version, and print the JSON
+Schema of the config file.
 `,
 		Aliases:           []string{"c"},
 		Args:              cobra.ExactArgs(1),
@@ -89,6 +96,8 @@ chain.
 	c.AddCommand(NewChainInit())
 	c.AddCommand(NewChainFaucet())
 	c.AddCommand(NewChainSimulate())
+	c.AddCommand(NewChainIndex())
+	c.AddCommand(NewChainConfig())
 
 	return c
 }
@@ -136,15 +145,13 @@ func configMigrationPreRunHandler(cmd *cobra.Command, args []string) (err error)
 			session.Printf("%s %s\n", icons.Info, colors.Infof(msgMigration, version, chainconfig.LatestVersion))
 		}
 
-		file, err := os.OpenFile(configPath, os.O_WRONLY|os.O_TRUNC, 0o755)
+		// Convert the current config to the latest version and update the YAML file
+		latest, err := chainconfig.Migrate(rawCfg)
 		if err != nil {
 			return err
 		}
 
-		defer file.Close()
-
-		// Convert the current config to the latest version and update the YAML file
-		return chainconfig.MigrateLatest(bytes.NewReader(rawCfg), file)
+		return os.WriteFile(configPath, latest, 0o755)
 	}
 
 	return nil
@@ -0,0 +1,204 @@
+package ignitecmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+)
+
+// NewChainConfig returns a command that groups sub commands related to the
+// blockchain config file.
+func NewChainConfig() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "config [command]",
+		Short: "Validate or migrate the blockchain config file, or generate its JSON Schema",
+		Args:  cobra.ExactArgs(1),
+		// The config file must not be migrated by these commands
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+	}
+
+	c.AddCommand(NewChainConfigValidate())
+	c.AddCommand(NewChainConfigMigrate())
+	c.AddCommand(NewChainConfigSchema())
+
+	return c
+}
+
+// NewChainConfigValidate returns a command that validates the blockchain config file.
+func NewChainConfigValidate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the blockchain config file",
+		Long: `The validate command checks the blockchain config file and reports the problems
+found in it along with the file line where they are found.
+
+Unlike the other chain commands, which ignore the keys they don't know, unknown
+keys are reported, so typos in the config file can be found. The coins of the
+accounts, faucet and validators, the account addresses, and the addresses of
+the validator servers are also checked, as well as the ports of the validator
+servers, which must not be used by more than one server.
+
+The config file must use the latest config version.
+`,
+		Args: cobra.NoArgs,
+		RunE: chainConfigValidateHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+// NewChainConfigMigrate returns a command that migrates the blockchain config file to the latest version.
+func NewChainConfigMigrate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the blockchain config file to the latest version",
+		Long: `The migrate command converts the blockchain config file to the latest config
+version and writes the result back to the file.
+
+Config files with a previous version are converted every time they are read, so
+the migration makes that conversion permanent. The comments and the order of the
+keys of the config file are kept where possible, and the changes are printed as
+a diff.
+
+Use the --dry-run flag to print the changes without updating the config file:
+
+	ignite chain config migrate --dry-run
+`,
+		Args: cobra.NoArgs,
+		RunE: chainConfigMigrateHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().Bool(flagDryRun, false, "print the changes without updating the config file")
+
+	return c
+}
+
+// NewChainConfigSchema returns a command that prints the JSON Schema of the blockchain config file.
+func NewChainConfigSchema() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the blockchain config file",
+		Long: `The schema command prints the JSON Schema of the latest version of the blockchain
+config file.
+
+The schema can be used by editors to autocomplete and validate the config file.
+For example, editors that use the YAML language server can use the schema by
+saving it next to the config file:
+
+	ignite chain config schema > config.schema.json
+
+And adding the following comment at the beginning of the config file:
+
+	# yaml-language-server: $schema=config.schema.json
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			schema, err := chainconfig.JSONSchema()
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(schema))
+			return err
+		},
+	}
+}
+
+func chainConfigValidateHandler(cmd *cobra.Command, _ []string) (err error) {
+	session := cliui.New(cliui.StartSpinner())
+	defer session.End()
+
+	configPath := getConfig(cmd)
+	if configPath == "" {
+		if configPath, err = chainconfig.LocateDefault(flagGetPath(cmd)); err != nil {
+			return err
+		}
+	}
+
+	issues, err := chainconfig.ValidateFile(configPath)
+	if err != nil {
+		return err
+	}
+
+	session.StopSpinner()
+
+	if len(issues) == 0 {
+		return session.Printf("%s Config file %s is valid\n", icons.OK, configPath)
+	}
+
+	for _, issue := range issues {
+		if err := session.Println(issue); err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("config file %s is not valid: %d problem(s) found", configPath, len(issues))
+}
+
+func chainConfigMigrateHandler(cmd *cobra.Command, _ []string) (err error) {
+	session := cliui.New()
+	defer session.End()
+
+	appPath := flagGetPath(cmd)
+	configPath := getConfig(cmd)
+	if configPath == "" {
+		if configPath, err = chainconfig.LocateDefault(appPath); err != nil {
+			return err
+		}
+	}
+
+	current, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+
+	version, err := chainconfig.ReadConfigVersion(bytes.NewReader(current))
+	if err != nil {
+		return err
+	}
+
+	if version == chainconfig.LatestVersion {
+		return session.Printf("%s Config file %s already uses the latest version %s\n", icons.OK, configPath, version)
+	}
+
+	latest, err := chainconfig.Migrate(current)
+	if err != nil {
+		return err
+	}
+
+	if err := session.Println(fileDiff(configPath, configPath, current, latest)); err != nil {
+		return err
+	}
+
+	if dryRun, _ := cmd.Flags().GetBool(flagDryRun); dryRun {
+		return session.Printf("%s Dry run, config file %s is not updated\n", icons.Info, configPath)
+	}
+
+	// Confirm before migrating the config if there are uncommitted changes
+	if !getYes(cmd) {
+		if err := confirmWhenUncommittedChanges(session, appPath); err != nil {
+			return err
+		}
+	}
+
+	if err := os.WriteFile(configPath, latest, 0o755); err != nil {
+		return err
+	}
+
+	return session.Printf(
+		"%s Config file %s migrated from %s to %s\n",
+		icons.OK,
+		configPath,
+		version,
+		chainconfig.LatestVersion,
+	)
+}
@@ -4,8 +4,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
+	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/chaincmd"
 	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet/clientbackend"
 	"github.com/ignite/cli/ignite/services/chain"
 )
 
@@ -14,13 +17,30 @@ func NewChainFaucet() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "faucet [address] [coin<,...>]",
 		Short: "Send coins to an account",
-		Args:  cobra.ExactArgs(2),
-		RunE:  chainFaucetHandler,
+		Long: `Send coins to an account using the faucet account of the chain.
+
+By default, the faucet configured in the config.yml of the chain is used to
+send the coins with the chain's binary.
+
+When the --node flag is used, the coins are sent from the --from account of
+the local keyring to a remote node, without requiring the chain's binary:
+
+	ignite chain faucet cosmos1... 10token --node https://rpc.example.com:443 --from faucet
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: chainFaucetHandler,
 	}
 
 	flagSetPath(c)
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().BoolP("verbose", "v", false, "Verbose output")
+	c.Flags().String(flagNode, "", "<host>:<port> to tendermint rpc interface of a remote node")
+	c.Flags().String(flagFrom, cosmosfaucet.DefaultAccountName, "faucet account name to use with --node")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().AddFlagSet(flagSetGasFlags())
+	c.Flags().String(flagFees, "", "Fees to pay along with transaction; eg: 10uatom")
 
 	return c
 }
@@ -36,6 +56,37 @@ func chainFaucetHandler(cmd *cobra.Command, args []string) error {
 	)
 	defer session.End()
 
+	var (
+		faucet cosmosfaucet.Faucet
+		err    error
+	)
+
+	if getNode(cmd) != "" {
+		faucet, err = newNodeFaucet(cmd)
+	} else {
+		faucet, err = newChainFaucet(cmd, session)
+	}
+	if err != nil {
+		return err
+	}
+
+	// parse provided coins
+	parsedCoins, err := sdk.ParseCoinsNormalized(coins)
+	if err != nil {
+		return err
+	}
+
+	// perform transfer from faucet
+	txHash, err := faucet.Transfer(cmd.Context(), toAddress, parsedCoins)
+	if err != nil {
+		return err
+	}
+
+	return session.Printf("📨 Coins sent. (hash = %s)\n", txHash)
+}
+
+// newChainFaucet creates the faucet configured for the chain in the current app path.
+func newChainFaucet(cmd *cobra.Command, session *cliui.Session) (cosmosfaucet.Faucet, error) {
 	chainOption := []chain.Option{
 		chain.KeyringBackend(chaincmd.KeyringBackendTest),
 		chain.WithOutputer(session),
@@ -44,24 +95,41 @@ func chainFaucetHandler(cmd *cobra.Command, args []string) error {
 
 	c, err := NewChainWithHomeFlags(cmd, chainOption...)
 	if err != nil {
-		return err
+		return cosmosfaucet.Faucet{}, err
 	}
 
-	faucet, err := c.Faucet(cmd.Context())
+	return c.Faucet(cmd.Context())
+}
+
+// newNodeFaucet creates a faucet that sends coins to a remote node without using the chain's binary.
+func newNodeFaucet(cmd *cobra.Command) (cosmosfaucet.Faucet, error) {
+	client, err := newNodeCosmosClient(cmd)
 	if err != nil {
-		return err
+		return cosmosfaucet.Faucet{}, err
 	}
 
-	// parse provided coins
-	parsedCoins, err := sdk.ParseCoinsNormalized(coins)
+	ledgerPath, err := chain.DefaultFaucetLedgerPath()
 	if err != nil {
-		return err
+		return cosmosfaucet.Faucet{}, err
 	}
 
-	// perform transfer from faucet
-	if err := faucet.Transfer(cmd.Context(), toAddress, parsedCoins); err != nil {
-		return err
+	storage, err := cache.NewStorage(ledgerPath)
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
 	}
 
-	return session.Println("📨 Coins sent.")
+	backend := clientbackend.New(client)
+
+	chainID, err := backend.ChainID(cmd.Context())
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+
+	return cosmosfaucet.NewWithBackend(
+		cmd.Context(),
+		backend,
+		cosmosfaucet.Account(getFrom(cmd), "", ""),
+		cosmosfaucet.ChainID(chainID),
+		cosmosfaucet.WithLedger(cosmosfaucet.NewEmbeddedLedger(storage, chainID)),
+	)
 }
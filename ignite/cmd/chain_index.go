@@ -0,0 +1,183 @@
+package ignitecmd
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/boltdb"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/postgres"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/sqlite"
+)
+
+const (
+	flagAdapter    = "adapter"
+	flagDatabase   = "database"
+	flagDBHost     = "db-host"
+	flagDBPort     = "db-port"
+	flagDBUser     = "db-user"
+	flagDBPassword = "db-password"
+	flagDBSSLMode  = "db-sslmode"
+	flagFromHeight = "from-height"
+	flagWorkers    = "workers"
+
+	adapterSQLite   = "sqlite"
+	adapterBoltDB   = "boltdb"
+	adapterPostgres = "postgres"
+
+	localRPCAddress = "http://localhost:26657"
+
+	// indexDirName is the name of the directory inside Ignite's config
+	// directory where the index database files are created by default.
+	indexDirName = "index"
+)
+
+// NewChainIndex creates a new index command to collect the transactions of a blockchain.
+func NewChainIndex() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "index",
+		Short: "Collect the transactions and events of a blockchain into a database",
+		Long: `The index command collects the transactions and events of each block of a
+blockchain and saves them into a database that can be queried later.
+
+The collection starts from the block after the latest one saved in the database,
+so an interrupted index can be resumed by running the command again. Once all
+the existing blocks are collected the command keeps waiting for new blocks until
+it is interrupted with Ctrl-C. When the connection to the node is lost the
+command keeps retrying until the node is available again.
+
+The hashes of the saved blocks are compared with the ones in the chain when the
+command starts and then periodically. When the node is reset, the data saved for
+the blocks that no longer exist in the chain is deleted before resuming.
+
+Blocks are fetched concurrently to speed up the collection of chains with many
+blocks, but they are always saved in block height order. The number of blocks
+fetched at the same time can be changed with the --workers flag.
+
+By default the transactions are saved into a SQLite database file inside
+Ignite's config directory. Other databases can be used with the --adapter flag:
+
+	ignite chain index --node https://rpc.example.com:443 --adapter boltdb --database ./txs.db
+
+To save the transactions into a PostgreSQL database use the database name:
+
+	ignite chain index --adapter postgres --database cosmos --db-user postgres
+`,
+		Args: cobra.NoArgs,
+		RunE: chainIndexHandler,
+		// The command doesn't require the config of a chain
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+	}
+
+	c.Flags().String(flagNode, localRPCAddress, "<host>:<port> to tendermint rpc interface of the node")
+	c.Flags().String(flagAdapter, adapterSQLite, fmt.Sprintf("database adapter (%s, %s or %s)", adapterSQLite, adapterBoltDB, adapterPostgres))
+	c.Flags().String(flagDatabase, "", "database file path or PostgreSQL database name (default: a file named after the chain ID)")
+	c.Flags().String(flagDBHost, postgres.DefaultHost, "PostgreSQL host")
+	c.Flags().Uint(flagDBPort, postgres.DefaultPort, "PostgreSQL port")
+	c.Flags().String(flagDBUser, "", "PostgreSQL user")
+	c.Flags().String(flagDBPassword, "", "PostgreSQL password")
+	c.Flags().String(flagDBSSLMode, "disable", "PostgreSQL SSL mode")
+	c.Flags().Int64(flagFromHeight, 1, "block height to start from when the database is empty")
+	c.Flags().Int(flagWorkers, 4, "number of blocks to fetch concurrently")
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().BoolP("verbose", "v", false, "Verbose output")
+
+	return c
+}
+
+func chainIndexHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New(
+		cliui.WithVerbosity(getVerbosity(cmd)),
+		cliui.StartSpinnerWithText("Connecting to the node..."),
+	)
+	defer session.End()
+
+	workers, _ := cmd.Flags().GetInt(flagWorkers)
+	client, err := newNodeCosmosClient(cmd, cosmosclient.WithCollectWorkers(workers))
+	if err != nil {
+		return err
+	}
+
+	status, err := client.Status(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	db, err := newIndexAdapter(cmd, status.NodeInfo.Network)
+	if err != nil {
+		return err
+	}
+
+	if c, ok := db.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	fromHeight, _ := cmd.Flags().GetInt64(flagFromHeight)
+	follower := cosmostxcollector.NewFollower(
+		db,
+		client,
+		cosmostxcollector.FromHeight(fromHeight),
+		cosmostxcollector.CollectEvents(session.EventBus()),
+	)
+
+	if err := follower.Follow(cmd.Context()); err != nil {
+		return err
+	}
+
+	return session.Printf("%s Transactions of %s saved into the %s database\n", icons.OK, status.NodeInfo.Network, db.GetType())
+}
+
+// newIndexAdapter creates the database adapter selected with the command flags.
+func newIndexAdapter(cmd *cobra.Command, chainID string) (adapter.Adapter, error) {
+	var (
+		adapterType, _ = cmd.Flags().GetString(flagAdapter)
+		database, _    = cmd.Flags().GetString(flagDatabase)
+	)
+
+	if database == "" && adapterType != adapterPostgres {
+		configDir, err := chainconfig.ConfigDirPath()
+		if err != nil {
+			return nil, err
+		}
+
+		database = filepath.Join(configDir, indexDirName, fmt.Sprintf("%s.%s.db", chainID, adapterType))
+	}
+
+	switch adapterType {
+	case adapterSQLite:
+		return sqlite.NewAdapter(database)
+	case adapterBoltDB:
+		return boltdb.NewAdapter(database)
+	case adapterPostgres:
+		if database == "" {
+			return nil, fmt.Errorf("the --%s flag is required for the %s adapter", flagDatabase, adapterPostgres)
+		}
+
+		var (
+			host, _     = cmd.Flags().GetString(flagDBHost)
+			port, _     = cmd.Flags().GetUint(flagDBPort)
+			user, _     = cmd.Flags().GetString(flagDBUser)
+			password, _ = cmd.Flags().GetString(flagDBPassword)
+			sslMode, _  = cmd.Flags().GetString(flagDBSSLMode)
+		)
+
+		return postgres.NewAdapter(
+			database,
+			postgres.WithHost(host),
+			postgres.WithPort(port),
+			postgres.WithUser(user),
+			postgres.WithPassword(password),
+			postgres.WithParams(map[string]string{"sslmode": sslMode}),
+		)
+	}
+
+	return nil, fmt.Errorf("unknown database adapter: %s", adapterType)
+}
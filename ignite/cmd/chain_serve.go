@@ -9,6 +9,7 @@ import (
 
 const (
 	flagConfig          = "config"
+	flagConfigOverlay   = "config-overlay"
 	flagForceReset      = "force-reset"
 	flagGenerateClients = "generate-clients"
 	flagQuitOnFail      = "quit-on-fail"
@@ -3,12 +3,14 @@ package ignitecmd
 import (
 	"context"
 	"fmt"
+	"go/format"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 	"time"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
 	uilog "github.com/ignite/cli/ignite/pkg/cliui/log"
 	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/ignite/pkg/cosmosver"
@@ -33,6 +36,7 @@ const (
 	flagYes        = "yes"
 	flagClearCache = "clear-cache"
 	flagSkipProto  = "skip-proto"
+	flagDryRun     = "dry-run"
 
 	checkVersionTimeout = time.Millisecond * 600
 	cacheFileName       = "ignite_cache.db"
@@ -121,6 +125,7 @@ func getHome(cmd *cobra.Command) (home string) {
 func flagSetConfig() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.StringP(flagConfig, "c", "", "ignite config file (default: ./config.yml)")
+	fs.StringSlice(flagConfigOverlay, nil, "names of the config overlays to merge on top of the config file (e.g. \"ci\" for config.ci.yml)")
 	return fs
 }
 
@@ -129,6 +134,11 @@ func getConfig(cmd *cobra.Command) (config string) {
 	return
 }
 
+func getConfigOverlays(cmd *cobra.Command) (overlays []string) {
+	overlays, _ = cmd.Flags().GetStringSlice(flagConfigOverlay)
+	return
+}
+
 func flagSetYes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.BoolP(flagYes, "y", false, "answers interactive yes/no questions with yes")
@@ -160,12 +170,51 @@ func flagGetClearCache(cmd *cobra.Command) bool {
 	return clearCache
 }
 
+func flagSetDryRun(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagDryRun, false, "print the changes as a diff without modifying the app")
+}
+
+func flagGetDryRun(cmd *cobra.Command) bool {
+	dryRun, _ := cmd.Flags().GetBool(flagDryRun)
+	return dryRun
+}
+
+// newDryRun returns the in-memory file system of the scaffolding when the dry run flag is set.
+func newDryRun(cmd *cobra.Command) *xgenny.DryRun {
+	if !flagGetDryRun(cmd) {
+		return nil
+	}
+	return xgenny.NewDryRun()
+}
+
+// flagSetDryRunUnsupported adds a hidden dry run flag to the scaffolding commands that
+// can't run without writing to the disk, so the flag is rejected with a clear error
+// by checkDryRunUnsupported instead of an unknown flag error.
+func flagSetDryRunUnsupported(cmd *cobra.Command) {
+	flagSetDryRun(cmd)
+	_ = cmd.Flags().MarkHidden(flagDryRun)
+}
+
+// checkDryRunUnsupported returns an error when the dry run flag is set on a command
+// that doesn't support it.
+func checkDryRunUnsupported(cmd *cobra.Command) error {
+	if flagGetDryRun(cmd) {
+		return fmt.Errorf("%q doesn't support --%s", cmd.CommandPath(), flagDryRun)
+	}
+	return nil
+}
+
 func NewChainWithHomeFlags(cmd *cobra.Command, chainOption ...chain.Option) (*chain.Chain, error) {
 	// Check if custom home is provided
 	if home := getHome(cmd); home != "" {
 		chainOption = append(chainOption, chain.HomePath(home))
 	}
 
+	// Check if config overlays are provided
+	if overlays := getConfigOverlays(cmd); len(overlays) > 0 {
+		chainOption = append(chainOption, chain.ConfigOverlays(overlays...))
+	}
+
 	appPath := flagGetPath(cmd)
 	absPath, err := filepath.Abs(appPath)
 	if err != nil {
@@ -178,8 +227,12 @@ func NewChainWithHomeFlags(cmd *cobra.Command, chainOption ...chain.Option) (*ch
 var (
 	modifyPrefix = colors.Modified("modify ")
 	createPrefix = colors.Success("create ")
+	deletePrefix = colors.Error("delete ")
 	removePrefix = func(s string) string {
-		return strings.TrimPrefix(strings.TrimPrefix(s, modifyPrefix), createPrefix)
+		for _, prefix := range []string{modifyPrefix, createPrefix, deletePrefix} {
+			s = strings.TrimPrefix(s, prefix)
+		}
+		return s
 	}
 )
 
@@ -202,6 +255,14 @@ func sourceModificationToString(sm xgenny.SourceModification) (string, error) {
 		}
 		files = append(files, createPrefix+relativePath)
 	}
+	for _, removed := range sm.RemovedFiles() {
+		// get the relative app path from the current directory
+		relativePath, err := relativePath(removed)
+		if err != nil {
+			return "", err
+		}
+		files = append(files, deletePrefix+relativePath)
+	}
 
 	// sort filenames without prefix
 	sort.Slice(files, func(i, j int) bool {
@@ -214,6 +275,88 @@ func sourceModificationToString(sm xgenny.SourceModification) (string, error) {
 	return "\n" + strings.Join(files, "\n"), nil
 }
 
+// printDryRun prints the unified diffs of the files created or modified by a dry run.
+func printDryRun(session *cliui.Session, dryRun *xgenny.DryRun) error {
+	diffStr, err := dryRunToString(dryRun)
+	if err != nil {
+		return err
+	}
+
+	session.Println(diffStr)
+	return session.Printf("\n%s Dry run, the app is not modified\n\n", icons.Info)
+}
+
+// dryRunToString returns the unified diffs of the files created, modified or removed by a dry run.
+func dryRunToString(dryRun *xgenny.DryRun) (string, error) {
+	var diffs []string
+	for _, file := range dryRun.Files() {
+		// get the relative app path from the current directory
+		relativePath, err := relativePath(file)
+		if err != nil {
+			return "", err
+		}
+
+		fromFile := relativePath
+		current, err := os.ReadFile(file)
+		if os.IsNotExist(err) {
+			fromFile = os.DevNull
+		} else if err != nil {
+			return "", err
+		}
+
+		// format the Go files like the scaffolding does after the generation
+		toFile := relativePath
+		content, ok := dryRun.Content(file)
+		if !ok {
+			toFile = os.DevNull
+		}
+		latest := []byte(content)
+		if ok && filepath.Ext(file) == ".go" {
+			if formatted, err := format.Source(latest); err == nil {
+				latest = formatted
+			}
+		}
+		diffs = append(diffs, fileDiff(fromFile, toFile, current, latest))
+	}
+
+	return "\n" + strings.Join(diffs, "\n"), nil
+}
+
+// fileDiff returns a colored unified diff of the changes of a file.
+func fileDiff(fromFile, toFile string, current, latest []byte) string {
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(current),
+		B:        splitLines(latest),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		case strings.HasPrefix(line, "+"):
+			lines[i] = colors.Success(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = colors.Error(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = colors.Info(line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func splitLines(b []byte) []string {
+	lines := strings.SplitAfter(string(b), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
 func deprecated() []*cobra.Command {
 	return []*cobra.Command{
 		{
@@ -272,8 +415,8 @@ func checkNewVersion(ctx context.Context) {
 }
 
 // newApp create a new scaffold app
-func newApp(appPath string) (scaffolder.Scaffolder, error) {
-	sc, err := scaffolder.App(appPath)
+func newApp(appPath string, options ...scaffolder.Option) (scaffolder.Scaffolder, error) {
+	sc, err := scaffolder.App(appPath, options...)
 	if err != nil {
 		return sc, err
 	}
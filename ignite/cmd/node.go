@@ -28,7 +28,7 @@ func NewNode() *cobra.Command {
 	return c
 }
 
-func newNodeCosmosClient(cmd *cobra.Command) (cosmosclient.Client, error) {
+func newNodeCosmosClient(cmd *cobra.Command, extraOptions ...cosmosclient.Option) (cosmosclient.Client, error) {
 	var (
 		home           = getHome(cmd)
 		prefix         = getAddressPrefix(cmd)
@@ -65,6 +65,8 @@ func newNodeCosmosClient(cmd *cobra.Command) (cosmosclient.Client, error) {
 		options = append(options, cosmosclient.WithFees(fees))
 	}
 
+	options = append(options, extraOptions...)
+
 	return cosmosclient.New(cmd.Context(), options...)
 }
 
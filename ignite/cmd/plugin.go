@@ -9,8 +9,12 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/cliui/entrywriter"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
 	"github.com/ignite/cli/ignite/pkg/xgit"
 	"github.com/ignite/cli/ignite/services/plugin"
 )
@@ -21,18 +25,30 @@ var plugins []*plugin.Plugin
 
 const (
 	igniteCmdPrefix = "ignite "
+
+	flagPluginGlobal = "global"
 )
 
-// LoadPlugins tries to load all the plugins found in configuration.
+// LoadPlugins tries to load all the plugins found in the global configuration
+// and in the chain configuration.
 // If no configuration found, it returns w/o error.
 func LoadPlugins(ctx context.Context, rootCmd *cobra.Command) error {
-	// NOTE(tb) Not sure if it's the right place to load this.
-	chain, err := NewChainWithHomeFlags(rootCmd)
+	globalConf, err := plugin.ParseGlobalConfig()
 	if err != nil {
-		// Binary is run outside of an chain app, plugins can't be loaded
-		return nil
+		return err
+	}
+	// NOTE(tb) Not sure if it's the right place to load this.
+	var chainConf *plugin.Config
+	if chain, err := NewChainWithHomeFlags(rootCmd); err == nil {
+		// Binary is run inside a chain app, load the chain plugins too
+		if path := chain.ConfigPath(); path != "" {
+			chainConf, err = plugin.ParseConfig(path)
+			if err != nil {
+				return err
+			}
+		}
 	}
-	plugins, err = plugin.Load(ctx, chain)
+	plugins, err = plugin.Load(ctx, chainConf, globalConf)
 	if err != nil {
 		return err
 	}
@@ -48,7 +64,9 @@ func LoadPlugins(ctx context.Context, rootCmd *cobra.Command) error {
 			loadErrors = append(loadErrors, p.Path)
 		}
 	}
-	if len(loadErrors) > 0 {
+	// Plugin commands stay usable, so plugins that fail to load can be fixed,
+	// for instance by updating their locked version.
+	if len(loadErrors) > 0 && !runsPluginCmd(rootCmd) {
 		// unload any plugin that could have been loaded
 		UnloadPlugins()
 		printPlugins()
@@ -57,6 +75,16 @@ func LoadPlugins(ctx context.Context, rootCmd *cobra.Command) error {
 	return nil
 }
 
+// runsPluginCmd returns true when the command line runs one of the plugin
+// commands.
+func runsPluginCmd(rootCmd *cobra.Command) bool {
+	cmd, _, err := rootCmd.Find(os.Args[1:])
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(cmd.CommandPath()+" ", igniteCmdPrefix+"plugin ")
+}
+
 // UnloadPlugins releases any loaded plugins, which is basically killing the
 // plugin server instance.
 func UnloadPlugins() {
@@ -98,8 +126,21 @@ func linkPluginHook(rootCmd *cobra.Command, p *plugin.Plugin, hook plugin.Hook)
 		return
 	}
 
+	state, hooked := hookExecutions[cmd]
+	if !hooked {
+		state = &hookExecution{}
+		hookExecutions[cmd] = state
+	}
+	state.hooks++
+
 	preRun := cmd.PreRunE
 	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if !hooked {
+			// The first hook attached to the command is executed before
+			// the others, so it resets the state of the previous execution.
+			state.reset(cmd, args)
+		}
+
 		if preRun != nil {
 			err := preRun(cmd, args)
 			if err != nil {
@@ -107,17 +148,43 @@ func linkPluginHook(rootCmd *cobra.Command, p *plugin.Plugin, hook plugin.Hook)
 			}
 		}
 
-		return p.Interface.ExecuteHookPre(hook, args)
+		if state.skipped {
+			return nil
+		}
+
+		var result plugin.HookResult
+		err := withPluginClientAPI(cmd, state.session, func(api plugin.ClientAPI) (err error) {
+			result, err = p.Interface.ExecuteHookPre(newExecutedHook(cmd, hook, state.args, nil), api)
+			return err
+		})
+		if err == nil {
+			err = state.apply(cmd, hook, result)
+		}
+		if err != nil {
+			// The command and its post and clean up hooks are not executed
+			state.end()
+		}
+		return err
 	}
 
 	runCmd := cmd.RunE
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		args = state.args
 		if runCmd != nil {
-			err := runCmd(cmd, args)
-			// if the command has failed the `PostRun` will not execute. here we execute the cleanup step before returnning.
+			var err error
+			if !state.skipped {
+				err = runCmd(cmd, args)
+			}
+			// if the command has failed the `PostRun` will not execute. here we execute the post and cleanup steps before returnning.
 			if err != nil {
-				p.Interface.ExecuteHookCleanUp(hook, args)
+				withPluginClientAPI(cmd, state.session, func(api plugin.ClientAPI) error {
+					return p.Interface.ExecuteHookPost(newExecutedHook(cmd, hook, args, err), api)
+				})
+				withPluginClientAPI(cmd, state.session, func(api plugin.ClientAPI) error {
+					return p.Interface.ExecuteHookCleanUp(newExecutedHook(cmd, hook, args, err), api)
+				})
+				state.cleanedUp()
 			}
 
 			return err
@@ -128,21 +195,115 @@ func linkPluginHook(rootCmd *cobra.Command, p *plugin.Plugin, hook plugin.Hook)
 	}
 
 	postCmd := cmd.PostRunE
-	cmd.PostRunE = func(cmd *cobra.Command, args []string) error {
-		defer p.Interface.ExecuteHookCleanUp(hook, args)
-
-		if preRun != nil {
-			err := postCmd(cmd, args)
+	cmd.PostRunE = func(cmd *cobra.Command, args []string) (err error) {
+		args = state.args
+		defer func() {
+			withPluginClientAPI(cmd, state.session, func(api plugin.ClientAPI) error {
+				return p.Interface.ExecuteHookCleanUp(newExecutedHook(cmd, hook, args, err), api)
+			})
+			state.cleanedUp()
+		}()
+
+		if postCmd != nil {
+			err = postCmd(cmd, args)
 			if err != nil {
-				// dont return the error, log it and let execution continue to `Run`
 				return err
 			}
 		}
 
-		return p.Interface.ExecuteHookPost(hook, args)
+		if state.skipped {
+			// The command is not executed so there's nothing to post process
+			return nil
+		}
+
+		return withPluginClientAPI(cmd, state.session, func(api plugin.ClientAPI) error {
+			return p.Interface.ExecuteHookPost(newExecutedHook(cmd, hook, args, nil), api)
+		})
+	}
+}
+
+// hookExecutions holds the state of the plugin hooks attached to a command.
+var hookExecutions = make(map[*cobra.Command]*hookExecution)
+
+// hookExecution is the state shared by the plugin hooks attached to a
+// command while the command is executed.
+type hookExecution struct {
+	// args holds the command args, which can be replaced by the pre hooks.
+	args []string
+	// skipped is true when a pre hook skips the command execution.
+	skipped bool
+	// hooks is the number of hooks attached to the command and cleaned the
+	// number of hooks that executed their clean up hook.
+	hooks, cleaned int
+	// session is shared by the plugin hooks of the command execution, it ends
+	// once all the hooks are cleaned up.
+	session *cliui.Session
+}
+
+func (e *hookExecution) reset(cmd *cobra.Command, args []string) {
+	e.end()
+	e.args = args
+	e.skipped = false
+	e.cleaned = 0
+	e.session = cliui.New(cliui.WithVerbosity(getVerbosity(cmd)))
+}
+
+// cleanedUp ends the session once the last hook of the command is cleaned up.
+func (e *hookExecution) cleanedUp() {
+	e.cleaned++
+	if e.cleaned == e.hooks {
+		e.end()
 	}
 }
 
+func (e *hookExecution) end() {
+	if e.session != nil {
+		e.session.End()
+		e.session = nil
+	}
+}
+
+// apply applies the result of a pre hook to the command execution.
+func (e *hookExecution) apply(cmd *cobra.Command, hook plugin.Hook, result plugin.HookResult) error {
+	switch result.Decision {
+	case plugin.HookContinue:
+	case plugin.HookSkip:
+		e.skipped = true
+		return e.session.Printf("%s Command skipped by plugin hook %q: %s\n", icons.Info, hook.Name, result.Reason)
+	case plugin.HookAbort:
+		return errors.Errorf("command aborted by plugin hook %q: %s", hook.Name, result.Reason)
+	default:
+		return errors.Errorf("invalid decision %d returned by plugin hook %q", result.Decision, hook.Name)
+	}
+	if result.Args != nil {
+		e.args = result.Args
+	}
+	if err := plugin.SetFlagValues(cmd.Flags(), result.Flags...); err != nil {
+		return errors.Wrapf(err, "plugin hook %q", hook.Name)
+	}
+	return nil
+}
+
+// newExecutedHook returns the context of a hook attached to a command.
+func newExecutedHook(cmd *cobra.Command, hook plugin.Hook, args []string, execErr error) plugin.ExecutedHook {
+	hook.Flags = plugin.FlagsFromFlagSet(cmd.Flags())
+	h := plugin.ExecutedHook{
+		Hook:        hook,
+		CommandPath: cmd.CommandPath(),
+		Args:        args,
+	}
+	if wd, err := os.Getwd(); err == nil {
+		h.WorkingDir = wd
+	}
+	if info, err := getChainInfo(cmd); err == nil {
+		h.Chain = &info
+	}
+	if execErr != nil {
+		h.ExecuteError = execErr.Error()
+	}
+	return h
+}
+
 // linkPluginCmds tries to add the plugin commands to the legacy ignite
 // commands.
 func linkPluginCmds(rootCmd *cobra.Command, p *plugin.Plugin) {
@@ -186,6 +347,20 @@ func linkPluginCmd(rootCmd *cobra.Command, p *plugin.Plugin, pluginCmd plugin.Co
 		Short: pluginCmd.Short,
 		Long:  pluginCmd.Long,
 	}
+	for _, f := range pluginCmd.Flags {
+		fs := newCmd.Flags()
+		if f.Persistent {
+			fs = newCmd.PersistentFlags()
+		}
+		if err := checkPluginFlag(cmd, newCmd, f); err != nil {
+			p.Error = errors.Wrapf(err, "plugin command %q", pluginCmd.Use)
+			return
+		}
+		if err := plugin.AddFlags(fs, f); err != nil {
+			p.Error = errors.Wrapf(err, "plugin command %q", pluginCmd.Use)
+			return
+		}
+	}
 	cmd.AddCommand(newCmd)
 	if len(pluginCmd.Commands) == 0 {
 		// pluginCmd has no sub commands, so it's runnable
@@ -194,8 +369,15 @@ func linkPluginCmd(rootCmd *cobra.Command, p *plugin.Plugin, pluginCmd plugin.Co
 			pluginCmd.With = p.With
 			// Pass cobra cmd
 			pluginCmd.CobraCmd = cmd
+			// Pass the parsed flags, including the persistent flags of the
+			// parent commands
+			pluginCmd.Flags = plugin.FlagsFromFlagSet(cmd.Flags())
 			// Call the plugin Execute
-			err := p.Interface.Execute(pluginCmd, args)
+			session := cliui.New(cliui.WithVerbosity(getVerbosity(cmd)))
+			err := withPluginClientAPI(cmd, session, func(api plugin.ClientAPI) error {
+				return p.Interface.Execute(pluginCmd, args, api)
+			})
+			session.End()
 			// NOTE(tb): This pause gives enough time for go-plugin to sync the
 			// output from stdout/stderr of the plugin. Without that pause, this
 			// output can be discarded and not printed in the user console.
@@ -213,6 +395,25 @@ func linkPluginCmd(rootCmd *cobra.Command, p *plugin.Plugin, pluginCmd plugin.Co
 	}
 }
 
+// checkPluginFlag checks that the flag of a plugin command doesn't conflict with the
+// other flags of the command, the persistent flags of its parents and the help flag,
+// which are merged by cobra when the command is executed.
+func checkPluginFlag(parent, cmd *cobra.Command, f plugin.Flag) error {
+	if f.Name == "help" || f.Shorthand == "h" {
+		return errors.Errorf("flag %q conflicts with the help flag", f.Name)
+	}
+	sets := []*flag.FlagSet{cmd.Flags(), cmd.PersistentFlags()}
+	for c := parent; c != nil; c = c.Parent() {
+		sets = append(sets, c.PersistentFlags())
+	}
+	for _, fs := range sets {
+		if err := plugin.CheckFlag(fs, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func findCommandByPath(cmd *cobra.Command, cmdPath string) *cobra.Command {
 	if cmd.CommandPath() == cmdPath {
 		return cmd
@@ -234,6 +435,8 @@ func NewPlugin() *cobra.Command {
 
 	c.AddCommand(NewPluginList())
 	c.AddCommand(NewPluginUpdate())
+	c.AddCommand(NewPluginAdd())
+	c.AddCommand(NewPluginRemove())
 	c.AddCommand(NewPluginScaffold())
 	return c
 }
@@ -253,11 +456,19 @@ func NewPluginUpdate() *cobra.Command {
 	return &cobra.Command{
 		Use:   "update [path]",
 		Short: "Update plugins",
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Fetches and builds the remote plugins again from their references.
+
+The resolved commits and the checksums of the plugin binaries are saved in
+the plugins.lock file located next to the config that declares the plugins.
+The lock file is used to install the same plugin versions everywhere, and
+this command is the only one that changes the locked commits. The binary
+checksums are locked by OS, architecture and Go version, the checksum of a
+new platform is added to the lock the first time the plugin is built on it.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
 				// update all plugins
-				err := plugin.Update(plugins...)
+				err := plugin.Update(cmd.Context(), plugins...)
 				if err != nil {
 					return err
 				}
@@ -267,7 +478,7 @@ func NewPluginUpdate() *cobra.Command {
 			// find the plugin to update
 			for _, p := range plugins {
 				if p.Path == args[0] {
-					err := plugin.Update(p)
+					err := plugin.Update(cmd.Context(), p)
 					if err != nil {
 						return err
 					}
@@ -280,6 +491,119 @@ func NewPluginUpdate() *cobra.Command {
 	}
 }
 
+func NewPluginAdd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "add [path] [key=value]...",
+		Short: "Adds a plugin declaration to a plugin configuration",
+		Long: `Adds a plugin declaration to the chain config.yml or, when the --global flag
+is used, to the global plugins configuration.
+
+Global plugins are available in any directory, while the plugins of a chain are
+only available inside the chain directory.
+
+The plugin path is either a repository URL, for instance github.com/org/repo,
+or an absolute local path. Local paths can be plugin sources, or prebuilt
+plugins which don't need a Go toolchain: a directory or a tarball (.tar.gz)
+that contains the plugin binary and a manifest.yml file.
+
+Key value pairs declared after the plugin path are added to the "with" values
+of the plugin declaration. Example:
+
+	ignite plugin add github.com/org/my-plugin/ foo=bar baz=qux`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conf, err := getPluginsConfig(cmd)
+			if err != nil {
+				return err
+			}
+			p := chainconfig.Plugin{
+				Path: args[0],
+			}
+			for _, pa := range args[1:] {
+				kv := strings.Split(pa, "=")
+				if len(kv) != 2 {
+					return errors.Errorf("malformed key=value arg: %s", pa)
+				}
+				if p.With == nil {
+					p.With = make(map[string]string)
+				}
+				p.With[kv[0]] = kv[1]
+			}
+			if err := conf.Add(p); err != nil {
+				return err
+			}
+			if err := conf.Save(); err != nil {
+				return err
+			}
+			fmt.Printf("Plugin %q added to %s.\n", p.Path, conf.Path())
+			return nil
+		},
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetPluginGlobal())
+	return c
+}
+
+func NewPluginRemove() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "remove [path]",
+		Aliases: []string{"rm"},
+		Short:   "Removes a plugin declaration from a plugin configuration",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			conf, err := getPluginsConfig(cmd)
+			if err != nil {
+				return err
+			}
+			if err := conf.Remove(args[0]); err != nil {
+				return err
+			}
+			if err := conf.Save(); err != nil {
+				return err
+			}
+			lock, err := plugin.ParseLock(conf.LockPath())
+			if err != nil {
+				return err
+			}
+			if lock.Remove(args[0]) {
+				if err := lock.Save(); err != nil {
+					return err
+				}
+			}
+			fmt.Printf("Plugin %q removed from %s.\n", args[0], conf.Path())
+			return nil
+		},
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetPluginGlobal())
+	return c
+}
+
+func flagSetPluginGlobal() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.BoolP(flagPluginGlobal, "g", false, "use the global plugins configuration")
+	return fs
+}
+
+// getPluginsConfig returns the global plugins configuration when the global
+// flag is used, otherwise it returns the plugins configuration of the chain.
+func getPluginsConfig(cmd *cobra.Command) (*plugin.Config, error) {
+	if global, _ := cmd.Flags().GetBool(flagPluginGlobal); global {
+		return plugin.ParseGlobalConfig()
+	}
+	chain, err := NewChainWithHomeFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+	path := chain.ConfigPath()
+	if path == "" {
+		return nil, errors.Errorf("chain config not found, use the --%s flag to use the global plugins configuration", flagPluginGlobal)
+	}
+	return plugin.ParseConfig(path)
+}
+
 func NewPluginScaffold() *cobra.Command {
 	return &cobra.Command{
 		Use:   "scaffold [github.com/org/repo]",
@@ -302,11 +626,15 @@ func NewPluginScaffold() *cobra.Command {
 			message := `
 ⭐️ Successfully created a new plugin '%[1]s'.
 👉 update plugin code at '%[2]s/main.go'
+👉 test plugin code with 'go test ./...' in '%[2]s', tests use the plugintest package
 
 👉 test plugin integration by adding the following lines in a chain config.yaml:
 plugins:
 - path: %[2]s
 
+👉 or make it available to all the ignite commands with:
+ignite plugin add -g %[2]s
+
 👉 once the plugin is pushed to a repository, the config becomes:
 plugins:
 - path: %[1]s
@@ -328,7 +656,11 @@ func printPlugins() {
 		if p.Error != nil {
 			status = fmt.Sprintf("❌ Error: %v", p.Error)
 		}
-		entries = append(entries, []string{p.Path, status})
+		scope := "chain"
+		if p.Global {
+			scope = "global"
+		}
+		entries = append(entries, []string{p.Path, scope, status})
 	}
-	entrywriter.MustWrite(os.Stdout, []string{"path", "status"}, entries...)
+	entrywriter.MustWrite(os.Stdout, []string{"path", "config", "status"}, entries...)
 }
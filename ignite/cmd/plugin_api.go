@@ -0,0 +1,132 @@
+package ignitecmd
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/cliquiz"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/plugin"
+)
+
+// withPluginClientAPI calls a plugin method with a client API that plugins
+// can use to call back into ignite while the method is executed.
+// The events and the questions of the plugin go through the session of the
+// command execution, so they are printed along with the command output.
+func withPluginClientAPI(cmd *cobra.Command, session *cliui.Session, call func(plugin.ClientAPI) error) error {
+	return call(pluginClientAPI{cmd: cmd, session: session})
+}
+
+// pluginClientAPI implements the API that plugins use to call back into ignite.
+type pluginClientAPI struct {
+	cmd     *cobra.Command
+	session *cliui.Session
+}
+
+func (a pluginClientAPI) GetChainInfo() (plugin.ChainInfo, error) {
+	return getChainInfo(a.cmd)
+}
+
+// getChainInfo returns the information of the chain ignite is executed in.
+func getChainInfo(cmd *cobra.Command) (plugin.ChainInfo, error) {
+	c, err := NewChainWithHomeFlags(cmd)
+	if err != nil {
+		return plugin.ChainInfo{}, err
+	}
+	if c.ConfigPath() == "" {
+		return plugin.ChainInfo{}, errors.New("chain config not found")
+	}
+
+	appPath, err := filepath.Abs(flagGetPath(cmd))
+	if err != nil {
+		return plugin.ChainInfo{}, err
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return plugin.ChainInfo{}, err
+	}
+
+	home, err := c.Home()
+	if err != nil {
+		return plugin.ChainInfo{}, err
+	}
+
+	binaryName, err := c.Binary()
+	if err != nil {
+		return plugin.ChainInfo{}, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return plugin.ChainInfo{}, err
+	}
+
+	return plugin.ChainInfo{
+		AppPath:    appPath,
+		ConfigPath: c.ConfigPath(),
+		Home:       home,
+		BinaryName: binaryName,
+		ChainID:    chainID,
+		Config:     conf,
+	}, nil
+}
+
+func (a pluginClientAPI) SendEvent(e events.Event) error {
+	options := []events.Option{events.Icon(e.Icon)}
+
+	switch e.ProgressIndication {
+	case events.IndicationStart:
+		options = append(options, events.ProgressStart())
+	case events.IndicationUpdate:
+		options = append(options, events.ProgressUpdate())
+	case events.IndicationFinish:
+		options = append(options, events.ProgressFinish())
+	}
+
+	if e.Verbose {
+		options = append(options, events.Verbose())
+	}
+
+	a.session.EventBus().Send(e.Message, options...)
+
+	return nil
+}
+
+func (a pluginClientAPI) Ask(questions ...plugin.Question) (map[string]string, error) {
+	var (
+		answers = make([]string, len(questions))
+		quiz    = make([]cliquiz.Question, len(questions))
+	)
+
+	for i, q := range questions {
+		var options []cliquiz.Option
+		if q.DefaultAnswer != "" {
+			options = append(options, cliquiz.DefaultAnswer(q.DefaultAnswer))
+		}
+
+		if q.Required {
+			options = append(options, cliquiz.Required())
+		}
+
+		if q.Hidden {
+			options = append(options, cliquiz.HideAnswer())
+		}
+
+		quiz[i] = cliquiz.NewQuestion(q.Text, &answers[i], options...)
+	}
+
+	if err := a.session.Ask(quiz...); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(questions))
+	for i, q := range questions {
+		values[q.Name] = answers[i]
+	}
+
+	return values, nil
+}
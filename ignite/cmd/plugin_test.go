@@ -1,6 +1,7 @@
 package ignitecmd
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -25,6 +26,14 @@ type pluginInterface struct {
 	hookCalls map[string][]string
 	// holds arguments tied to the ExecuteHook* methods' invocation.
 	hookArgs map[string]map[string][]string
+	// executed holds the executed commands.
+	executed []plugin.Command
+	// preHooks holds the executed pre hooks.
+	preHooks []plugin.ExecutedHook
+	// postHooks holds the executed post hooks.
+	postHooks []plugin.ExecutedHook
+	// preHookResult is returned by ExecuteHookPre.
+	preHookResult plugin.HookResult
 }
 
 func (p *pluginInterface) Commands() []plugin.Command {
@@ -35,17 +44,20 @@ func (p *pluginInterface) Hooks() []plugin.Hook {
 	return p.hooks
 }
 
-func (p *pluginInterface) Execute(c plugin.Command, args []string) error {
+func (p *pluginInterface) Execute(c plugin.Command, args []string, api plugin.ClientAPI) error {
+	p.executed = append(p.executed, c)
 	return nil
 }
 
-func (p *pluginInterface) ExecuteHookPre(hook plugin.Hook, args []string) error {
+func (p *pluginInterface) ExecuteHookPre(hook plugin.ExecutedHook, api plugin.ClientAPI) (plugin.HookResult, error) {
+	args := hook.Args
 	if p.hookCalls == nil {
 		p.hookCalls = make(map[string][]string)
 	}
 
 	p.hookCalls[hook.PlaceHookOn] = append(p.hookCalls[hook.PlaceHookOn],
 		fmt.Sprintf("pre-%s", hook.Name))
+	p.preHooks = append(p.preHooks, hook)
 
 	if p.hookArgs == nil && len(args) > 0 {
 		p.hookArgs = make(map[string]map[string][]string)
@@ -53,15 +65,17 @@ func (p *pluginInterface) ExecuteHookPre(hook plugin.Hook, args []string) error
 		p.hookArgs[hook.PlaceHookOn] = make(map[string][]string)
 		p.hookArgs[hook.PlaceHookOn]["pre"] = args
 	}
-	return nil
+	return p.preHookResult, nil
 }
 
-func (p *pluginInterface) ExecuteHookPost(hook plugin.Hook, args []string) error {
+func (p *pluginInterface) ExecuteHookPost(hook plugin.ExecutedHook, api plugin.ClientAPI) error {
+	args := hook.Args
 	if p.hookCalls == nil {
 		p.hookCalls = make(map[string][]string)
 	}
 	p.hookCalls[hook.PlaceHookOn] = append(p.hookCalls[hook.PlaceHookOn],
 		fmt.Sprintf("post-%s", hook.Name))
+	p.postHooks = append(p.postHooks, hook)
 
 	if p.hookArgs == nil && len(args) > 0 {
 		p.hookArgs = make(map[string]map[string][]string)
@@ -74,7 +88,8 @@ func (p *pluginInterface) ExecuteHookPost(hook plugin.Hook, args []string) error
 	return nil
 }
 
-func (p *pluginInterface) ExecuteHookCleanUp(hook plugin.Hook, args []string) error {
+func (p *pluginInterface) ExecuteHookCleanUp(hook plugin.ExecutedHook, api plugin.ClientAPI) error {
+	args := hook.Args
 	if p.hookCalls == nil {
 		p.hookCalls = make(map[string][]string)
 	}
@@ -332,6 +347,323 @@ ignite
 	}
 }
 
+func TestLinkPluginCmdFlags(t *testing.T) {
+	// Arrange
+	pi := &pluginInterface{
+		commands: []plugin.Command{
+			{
+				Use: "foo",
+				Flags: []plugin.Flag{
+					{Name: "output", Shorthand: "o", DefValue: "json", Persistent: true},
+				},
+				Commands: []plugin.Command{
+					{
+						Use: "bar",
+						Flags: []plugin.Flag{
+							{Name: "force", Shorthand: "f", Type: plugin.FlagTypeBool},
+							{Name: "count", Type: plugin.FlagTypeInt, DefValue: "1"},
+						},
+					},
+				},
+			},
+		},
+	}
+	p := &plugin.Plugin{
+		Plugin:    chainconfig.Plugin{Path: "foo"},
+		Interface: pi,
+	}
+	rootCmd := buildRootCmd()
+
+	linkPluginCmds(rootCmd, p)
+	require.NoError(t, p.Error)
+
+	// Act
+	rootCmd.SetArgs([]string{"foo", "bar", "-o", "yaml", "--force"})
+	err := rootCmd.Execute()
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, pi.executed, 1)
+
+	fs, err := pi.executed[0].FlagSet()
+	require.NoError(t, err)
+
+	output, _ := fs.GetString("output")
+	require.Equal(t, "yaml", output)
+	force, _ := fs.GetBool("force")
+	require.True(t, force)
+	count, _ := fs.GetInt("count")
+	require.Equal(t, 1, count)
+}
+
+func TestLinkPluginCmdFlagsWithInvalidType(t *testing.T) {
+	// Arrange
+	p := &plugin.Plugin{
+		Plugin: chainconfig.Plugin{Path: "foo"},
+		Interface: &pluginInterface{
+			commands: []plugin.Command{
+				{
+					Use:   "foo",
+					Flags: []plugin.Flag{{Name: "timeout", Type: "duration"}},
+				},
+			},
+		},
+	}
+
+	// Act
+	linkPluginCmds(buildRootCmd(), p)
+
+	// Assert
+	require.EqualError(t, p.Error, `plugin command "foo": unsupported type "duration" for flag "timeout"`)
+}
+
+func TestLinkPluginCmdFlagsConflicts(t *testing.T) {
+	tests := []struct {
+		name          string
+		command       plugin.Command
+		expectedError string
+	}{
+		{
+			name: "fail: flag defined twice",
+			command: plugin.Command{
+				Use:   "foo",
+				Flags: []plugin.Flag{{Name: "output"}, {Name: "output", Persistent: true}},
+			},
+			expectedError: `plugin command "foo": flag "output" is already defined`,
+		},
+		{
+			name: "fail: shorthand of a parent persistent flag",
+			command: plugin.Command{
+				Use:   "foo",
+				Flags: []plugin.Flag{{Name: "chain-id", Shorthand: "c"}},
+			},
+			expectedError: `plugin command "foo": shorthand "c" of flag "chain-id" is already used by flag "config"`,
+		},
+		{
+			name: "fail: flag of a parent plugin command",
+			command: plugin.Command{
+				Use:   "foo",
+				Flags: []plugin.Flag{{Name: "output", Shorthand: "o", Persistent: true}},
+				Commands: []plugin.Command{{
+					Use:   "bar",
+					Flags: []plugin.Flag{{Name: "owner", Shorthand: "o"}},
+				}},
+			},
+			expectedError: `plugin command "bar": shorthand "o" of flag "owner" is already used by flag "output"`,
+		},
+		{
+			name: "fail: help flag",
+			command: plugin.Command{
+				Use:   "foo",
+				Flags: []plugin.Flag{{Name: "host", Shorthand: "h"}},
+			},
+			expectedError: `plugin command "foo": flag "host" conflicts with the help flag`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			p := &plugin.Plugin{
+				Plugin:    chainconfig.Plugin{Path: "foo"},
+				Interface: &pluginInterface{commands: []plugin.Command{tt.command}},
+			}
+			rootCmd := buildRootCmd()
+			rootCmd.PersistentFlags().StringP("config", "c", "", "config file")
+
+			// Act
+			linkPluginCmds(rootCmd, p)
+
+			// Assert
+			require.EqualError(t, p.Error, tt.expectedError)
+		})
+	}
+}
+
+func TestLinkPluginHookFlags(t *testing.T) {
+	// Arrange
+	pi := &pluginInterface{
+		hooks: []plugin.Hook{
+			{Name: "test-hook", PlaceHookOn: "scaffold chain"},
+		},
+	}
+	p := &plugin.Plugin{
+		Plugin:    chainconfig.Plugin{Path: "foo"},
+		Interface: pi,
+	}
+	rootCmd := buildRootCmd()
+	chainCmd := findCommandByPath(rootCmd, "ignite scaffold chain")
+	chainCmd.Flags().String("address-prefix", "cosmos", "account address prefix")
+
+	linkPluginHooks(rootCmd, p)
+	require.NoError(t, p.Error)
+
+	// Act
+	rootCmd.SetArgs([]string{"scaffold", "chain", "--address-prefix", "ignite"})
+	err := rootCmd.Execute()
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, pi.preHooks, 1)
+
+	fs, err := pi.preHooks[0].FlagSet()
+	require.NoError(t, err)
+
+	prefix, _ := fs.GetString("address-prefix")
+	require.Equal(t, "ignite", prefix)
+}
+
+func TestLinkPluginHookResult(t *testing.T) {
+	tests := []struct {
+		name          string
+		result        plugin.HookResult
+		expectedError string
+		expectedRun   bool
+		expectedArgs  []string
+		expectedFlag  string
+		expectedCalls []string
+	}{
+		{
+			name:          "ok: continue",
+			expectedRun:   true,
+			expectedArgs:  []string{"foo"},
+			expectedFlag:  "cosmos",
+			expectedCalls: []string{"pre-test-hook", "post-test-hook", "cleanup-test-hook"},
+		},
+		{
+			name: "ok: continue with modified args and flags",
+			result: plugin.HookResult{
+				Args:  []string{"bar", "baz"},
+				Flags: []plugin.Flag{{Name: "address-prefix", Value: "ignite"}},
+			},
+			expectedRun:   true,
+			expectedArgs:  []string{"bar", "baz"},
+			expectedFlag:  "ignite",
+			expectedCalls: []string{"pre-test-hook", "post-test-hook", "cleanup-test-hook"},
+		},
+		{
+			name: "ok: skip",
+			result: plugin.HookResult{
+				Decision: plugin.HookSkip,
+				Reason:   "nothing to do",
+			},
+			expectedCalls: []string{"pre-test-hook", "cleanup-test-hook"},
+		},
+		{
+			name: "fail: abort",
+			result: plugin.HookResult{
+				Decision: plugin.HookAbort,
+				Reason:   "not allowed",
+			},
+			expectedError: `command aborted by plugin hook "test-hook": not allowed`,
+			expectedCalls: []string{"pre-test-hook"},
+		},
+		{
+			name: "fail: unknown flag",
+			result: plugin.HookResult{
+				Flags: []plugin.Flag{{Name: "unknown", Value: "foo"}},
+			},
+			expectedError: `plugin hook "test-hook": unknown flag "unknown"`,
+			expectedCalls: []string{"pre-test-hook"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			pi := &pluginInterface{
+				hooks: []plugin.Hook{
+					{Name: "test-hook", PlaceHookOn: "scaffold chain"},
+				},
+				hookArgs:      make(map[string]map[string][]string),
+				preHookResult: tt.result,
+			}
+			p := &plugin.Plugin{
+				Plugin:    chainconfig.Plugin{Path: "foo"},
+				Interface: pi,
+			}
+			var (
+				run  bool
+				args []string
+				flag string
+			)
+			rootCmd := buildRootCmd()
+			rootCmd.SilenceErrors = true
+			rootCmd.SilenceUsage = true
+			chainCmd := findCommandByPath(rootCmd, "ignite scaffold chain")
+			chainCmd.Flags().String("address-prefix", "cosmos", "account address prefix")
+			chainCmd.RunE = func(cmd *cobra.Command, a []string) error {
+				run = true
+				args = a
+				flag, _ = cmd.Flags().GetString("address-prefix")
+				return nil
+			}
+
+			linkPluginHooks(rootCmd, p)
+			require.NoError(t, p.Error)
+
+			// Act
+			rootCmd.SetArgs([]string{"scaffold", "chain", "foo"})
+			err := rootCmd.Execute()
+
+			// Assert
+			require.Equal(t, tt.expectedCalls, pi.hookCalls["scaffold chain"])
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedRun, run)
+			if tt.expectedRun {
+				require.Equal(t, tt.expectedArgs, args)
+				require.Equal(t, tt.expectedFlag, flag)
+				require.Len(t, pi.postHooks, 1)
+				require.Equal(t, tt.expectedArgs, pi.postHooks[0].Args)
+			}
+		})
+	}
+}
+
+func TestLinkPluginHookContext(t *testing.T) {
+	// Arrange
+	pi := &pluginInterface{
+		hooks: []plugin.Hook{
+			{Name: "test-hook", PlaceHookOn: "scaffold chain"},
+		},
+		hookArgs: make(map[string]map[string][]string),
+	}
+	p := &plugin.Plugin{
+		Plugin:    chainconfig.Plugin{Path: "foo"},
+		Interface: pi,
+	}
+	rootCmd := buildRootCmd()
+	rootCmd.SilenceErrors = true
+	rootCmd.SilenceUsage = true
+	chainCmd := findCommandByPath(rootCmd, "ignite scaffold chain")
+	chainCmd.RunE = func(*cobra.Command, []string) error {
+		return errors.New("oops")
+	}
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	linkPluginHooks(rootCmd, p)
+	require.NoError(t, p.Error)
+
+	// Act
+	rootCmd.SetArgs([]string{"scaffold", "chain", "foo"})
+	err = rootCmd.Execute()
+
+	// Assert
+	require.EqualError(t, err, "oops")
+	require.Equal(t, []string{"pre-test-hook", "post-test-hook", "cleanup-test-hook"}, pi.hookCalls["scaffold chain"])
+	require.Len(t, pi.preHooks, 1)
+	require.Equal(t, "ignite scaffold chain", pi.preHooks[0].CommandPath)
+	require.Equal(t, []string{"foo"}, pi.preHooks[0].Args)
+	require.Equal(t, wd, pi.preHooks[0].WorkingDir)
+	require.Nil(t, pi.preHooks[0].Chain)
+	require.Empty(t, pi.preHooks[0].ExecuteError)
+	require.Len(t, pi.postHooks, 1)
+	require.Equal(t, "oops", pi.postHooks[0].ExecuteError)
+}
+
 // dumpCmd helps in comparing cobra.Command by writing their Use and Commands.
 // Runnable commands are marked with a *.
 func dumpCmd(c *cobra.Command, w io.Writer, ntabs int) {
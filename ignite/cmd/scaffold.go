@@ -99,6 +99,7 @@ with an "--ibc" flag. Note that the default module is not IBC-enabled.
 	c.AddCommand(NewScaffoldBandchain())
 	c.AddCommand(NewScaffoldVue())
 	c.AddCommand(NewScaffoldReact())
+	c.AddCommand(NewScaffoldRemove())
 	// c.AddCommand(NewScaffoldWasm())
 
 	return c
@@ -141,7 +142,8 @@ func scaffoldType(
 	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
 	defer session.End()
 
-	sc, err := newApp(appPath)
+	dryRun := newDryRun(cmd)
+	sc, err := newApp(appPath, scaffolder.WithDryRun(dryRun))
 	if err != nil {
 		return err
 	}
@@ -151,11 +153,15 @@ func scaffoldType(
 		return err
 	}
 
-	sm, err := sc.AddType(cmd.Context(), cacheStorage, typeName, placeholder.New(), kind, options...)
+	sm, err := sc.AddType(cmd.Context(), cacheStorage, typeName, newTracer(), kind, options...)
 	if err != nil {
 		return err
 	}
 
+	if dryRun != nil {
+		return printDryRun(session, dryRun)
+	}
+
 	modificationsStr, err := sourceModificationToString(sm)
 	if err != nil {
 		return err
@@ -167,9 +173,15 @@ func scaffoldType(
 	return nil
 }
 
+// newTracer returns the placeholder tracer of the scaffolding commands. The placeholders
+// removed from the Go files are located in their AST so the code can still be scaffolded.
+func newTracer() *placeholder.Tracer {
+	return placeholder.New(placeholder.WithLocator(scaffolder.LocatePlaceholder))
+}
+
 func gitChangesConfirmPreRunHandler(cmd *cobra.Command, args []string) error {
-	// Don't confirm when the "--yes" flag is present
-	if getYes(cmd) {
+	// Don't confirm when the "--yes" flag is present or when the app is not modified
+	if getYes(cmd) || flagGetDryRun(cmd) {
 		return nil
 	}
 
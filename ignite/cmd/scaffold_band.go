@@ -6,7 +6,6 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
-	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/services/scaffolder"
 )
 
@@ -32,6 +31,7 @@ func NewScaffoldBandchain() *cobra.Command {
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	flagSetDryRun(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().String(flagModule, "", "IBC Module to add the packet into")
@@ -68,17 +68,22 @@ func createBandchainHandler(cmd *cobra.Command, args []string) error {
 		options = append(options, scaffolder.OracleWithSigner(signer)) // nolint: staticcheck
 	}
 
-	sc, err := newApp(appPath)
+	dryRun := newDryRun(cmd)
+	sc, err := newApp(appPath, scaffolder.WithDryRun(dryRun))
 	if err != nil {
 		return err
 	}
 
 	// nolint: staticcheck
-	sm, err := sc.AddOracle(cmd.Context(), cacheStorage, placeholder.New(), module, oracle, options...)
+	sm, err := sc.AddOracle(cmd.Context(), cacheStorage, newTracer(), module, oracle, options...)
 	if err != nil {
 		return err
 	}
 
+	if dryRun != nil {
+		return printDryRun(session, dryRun)
+	}
+
 	modificationsStr, err := sourceModificationToString(sm)
 	if err != nil {
 		return err
@@ -4,7 +4,6 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
-	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/services/scaffolder"
 )
 
@@ -76,11 +75,16 @@ about Cosmos SDK on https://docs.cosmos.network
 	c.Flags().AddFlagSet(flagSetAccountPrefixes())
 	c.Flags().StringP(flagPath, "p", ".", "Create a project in a specific path")
 	c.Flags().Bool(flagNoDefaultModule, false, "Create a project without a default module")
+	flagSetDryRunUnsupported(c)
 
 	return c
 }
 
 func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
+	if err := checkDryRunUnsupported(cmd); err != nil {
+		return err
+	}
+
 	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
 	defer session.End()
 
@@ -99,7 +103,7 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 	appdir, err := scaffolder.Init(
 		cmd.Context(),
 		cacheStorage,
-		placeholder.New(),
+		newTracer(),
 		appPath,
 		name,
 		addressPrefix,
@@ -51,9 +51,19 @@ business logic you will implement next.
 By default, all fields are assumed to be strings. If you want a field of a
 different type, you can specify it after a colon ":". The following types are
 supported: string, bool, int, uint, coin, array.string, array.int, array.uint,
-array.coin. An example of using custom types:
+array.coin, address, decimal, bytes, timestamp, duration and enum. An example of
+using custom types:
 
 	ignite scaffold list pool amount:coin tags:array.string height:int
+
+Enum fields are defined with their values separated by "|":
+
+	ignite scaffold list order amount:coin status:enum=open|closed
+
+Validation constraints can be added after the type of a field, separated by
+commas. They generate the checks of the ValidateBasic methods of the messages:
+
+	ignite scaffold list post title:string:required,max=64 likes:uint:min=1
   
 Ignite also supports custom types:
   
@@ -93,6 +103,7 @@ The "creator" field is not generated if a list is scaffolded with the
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	flagSetDryRun(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
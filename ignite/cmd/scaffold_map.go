@@ -62,6 +62,7 @@ custom types.
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	flagSetDryRun(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
@@ -4,7 +4,6 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
-	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/services/scaffolder"
 )
 
@@ -66,6 +65,7 @@ for details.
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	flagSetDryRun(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().String(flagModule, "", "Module to add the message into. Default: app's main module")
@@ -112,16 +112,21 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		options = append(options, scaffolder.WithoutSimulation())
 	}
 
-	sc, err := newApp(appPath)
+	dryRun := newDryRun(cmd)
+	sc, err := newApp(appPath, scaffolder.WithDryRun(dryRun))
 	if err != nil {
 		return err
 	}
 
-	sm, err := sc.AddMessage(cmd.Context(), cacheStorage, placeholder.New(), module, args[0], args[1:], resFields, options...)
+	sm, err := sc.AddMessage(cmd.Context(), cacheStorage, newTracer(), module, args[0], args[1:], resFields, options...)
 	if err != nil {
 		return err
 	}
 
+	if dryRun != nil {
+		return printDryRun(session, dryRun)
+	}
+
 	modificationsStr, err := sourceModificationToString(sm)
 	if err != nil {
 		return err
@@ -9,7 +9,6 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
-	"github.com/ignite/cli/ignite/pkg/placeholder"
 	"github.com/ignite/cli/ignite/pkg/validation"
 	"github.com/ignite/cli/ignite/services/scaffolder"
 	modulecreate "github.com/ignite/cli/ignite/templates/module/create"
@@ -102,6 +101,7 @@ params.
 
 	flagSetPath(c)
 	flagSetClearCache(c)
+	flagSetDryRun(c)
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().StringSlice(flagDep, []string{}, "module dependencies (e.g. --dep account,bank,FeeGrant)")
@@ -179,12 +179,13 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 	var msg bytes.Buffer
 	fmt.Fprintf(&msg, "\n🎉 Module created %s.\n\n", name)
 
-	sc, err := newApp(appPath)
+	dryRun := newDryRun(cmd)
+	sc, err := newApp(appPath, scaffolder.WithDryRun(dryRun))
 	if err != nil {
 		return err
 	}
 
-	sm, err := sc.CreateModule(cmd.Context(), cacheStorage, placeholder.New(), name, options...)
+	sm, err := sc.CreateModule(cmd.Context(), cacheStorage, newTracer(), name, options...)
 	if err != nil {
 		var validationErr validation.Error
 		if !requireRegistration && errors.As(err, &validationErr) {
@@ -193,7 +194,7 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		} else {
 			return err
 		}
-	} else {
+	} else if dryRun == nil {
 		modificationsStr, err := sourceModificationToString(sm)
 		if err != nil {
 			return err
@@ -202,6 +203,10 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		session.Println(modificationsStr)
 	}
 
+	if dryRun != nil {
+		return printDryRun(session, dryRun)
+	}
+
 	// in previously scaffolded apps gov keeper is defined below the scaffolded module keeper definition
 	// therefore we must warn the user to manually move the definition if it's the case
 	// https://github.com/ignite/cli/issues/818#issuecomment-865736052
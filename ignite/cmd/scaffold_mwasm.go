@@ -4,7 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/ignite/cli/ignite/pkg/cliui"
-	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/services/scaffolder"
 )
 
 func NewScaffoldWasm() *cobra.Command {
@@ -17,6 +17,7 @@ func NewScaffoldWasm() *cobra.Command {
 	}
 
 	flagSetPath(c)
+	flagSetDryRun(c)
 
 	return c
 }
@@ -32,16 +33,21 @@ func scaffoldWasmHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	sc, err := newApp(appPath)
+	dryRun := newDryRun(cmd)
+	sc, err := newApp(appPath, scaffolder.WithDryRun(dryRun))
 	if err != nil {
 		return err
 	}
 
-	sm, err := sc.ImportModule(cmd.Context(), cacheStorage, placeholder.New(), "wasm")
+	sm, err := sc.ImportModule(cmd.Context(), cacheStorage, newTracer(), "wasm")
 	if err != nil {
 		return err
 	}
 
+	if dryRun != nil {
+		return printDryRun(session, dryRun)
+	}
+
 	modificationsStr, err := sourceModificationToString(sm)
 	if err != nil {
 		return err
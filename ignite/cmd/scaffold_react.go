@@ -21,10 +21,16 @@ func NewScaffoldReact() *cobra.Command {
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().StringP(flagPath, "p", "./"+chainconfig.DefaultReactPath, "path to scaffold content of the React app")
 
+	flagSetDryRunUnsupported(c)
+
 	return c
 }
 
 func scaffoldReactHandler(cmd *cobra.Command, args []string) error {
+	if err := checkDryRunUnsupported(cmd); err != nil {
+		return err
+	}
+
 	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
 	defer session.End()
 
@@ -0,0 +1,112 @@
+package ignitecmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/ignite/pkg/cliui"
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/services/scaffolder"
+)
+
+const statusRemoving = "Removing..."
+
+// NewScaffoldRemove returns the command to remove a scaffolded component.
+func NewScaffoldRemove() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "remove [type|message|query|packet|module] [name]",
+		Short: "Remove a scaffolded component",
+		Long: `Remove a component scaffolded by Ignite.
+
+Each scaffolding command records the files it creates and the code it inserts in
+the existing files in the ".ignite/journal.json" file of your project. The
+remove command uses this journal to delete the created files, strip the inserted
+code and generate the proto files again, without losing the changes done since.
+
+For example, to remove a list of posts scaffolded in the "blog" module:
+
+	ignite scaffold remove type post --module blog
+
+Lists, maps, singles and types are all removed with the "type" kind. Removing a
+module also removes the components scaffolded inside of it:
+
+	ignite scaffold remove module blog
+
+Only the components scaffolded with the journal can be removed. Code that was
+edited after scaffolding might not be found anymore, in which case it is listed
+so you can remove it manually.
+`,
+		Args:      cobra.ExactArgs(2),
+		ValidArgs: []string{scaffolder.ComponentType, scaffolder.ComponentMessage, scaffolder.ComponentQuery, scaffolder.ComponentPacket, scaffolder.ComponentModule},
+		PreRunE:   gitChangesConfirmPreRunHandler,
+		RunE:      scaffoldRemoveHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+	flagSetDryRun(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "Module of the component. Default: app's main module")
+
+	return c
+}
+
+func scaffoldRemoveHandler(cmd *cobra.Command, args []string) error {
+	var (
+		kind, name = args[0], args[1]
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	if err := cobra.OnlyValidArgs(cmd, args[:1]); err != nil {
+		return err
+	}
+
+	session := cliui.New(cliui.StartSpinnerWithText(statusRemoving))
+	defer session.End()
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	dryRun := newDryRun(cmd)
+	sc, err := newApp(appPath, scaffolder.WithDryRun(dryRun))
+	if err != nil {
+		return err
+	}
+
+	var options []scaffolder.RemoveOption
+	if moduleName != "" {
+		options = append(options, scaffolder.RemoveWithModule(moduleName))
+	}
+
+	sm, err := sc.Remove(cmd.Context(), cacheStorage, kind, name, options...)
+
+	var notRemovedErr scaffolder.SnippetsNotRemovedError
+	if err != nil && !errors.As(err, &notRemovedErr) {
+		return err
+	}
+
+	if dryRun != nil {
+		if len(notRemovedErr.Snippets) > 0 {
+			session.Printf("\n%s %s\n", icons.NotOK, notRemovedErr.Error())
+		}
+		return printDryRun(session, dryRun)
+	}
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	if len(notRemovedErr.Snippets) > 0 {
+		session.Printf("\n%s %s\n", icons.NotOK, notRemovedErr.Error())
+	}
+	session.Printf("\n🗑  Removed the %s `%s`.\n\n", kind, name)
+
+	return nil
+}
@@ -21,10 +21,16 @@ func NewScaffoldVue() *cobra.Command {
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().StringP(flagPath, "p", "./"+chainconfig.DefaultVuePath, "path to scaffold content of the Vue.js app")
 
+	flagSetDryRunUnsupported(c)
+
 	return c
 }
 
 func scaffoldVueHandler(cmd *cobra.Command, args []string) error {
+	if err := checkDryRunUnsupported(cmd); err != nil {
+		return err
+	}
+
 	session := cliui.New(cliui.StartSpinnerWithText(statusScaffolding))
 	defer session.End()
 
@@ -493,6 +493,19 @@ func (c ChainCmd) BankSendCommand(fromAddress, toAddress, amount string) step.Op
 	return c.cliCommand(command)
 }
 
+// BankBalancesCommand returns the command for querying the balances of an address.
+func (c ChainCmd) BankBalancesCommand(address string) step.Option {
+	command := []string{
+		commandQuery,
+		"bank",
+		"balances",
+		address,
+	}
+
+	command = c.attachNode(command)
+	return c.cliCommand(command)
+}
+
 // QueryTxCommand returns the command to query tx
 func (c ChainCmd) QueryTxCommand(txHash string) step.Option {
 	command := []string{
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/cenkalti/backoff"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 
 	"github.com/ignite/cli/ignite/pkg/chaincmd"
@@ -177,6 +178,42 @@ func (r Runner) BankSend(ctx context.Context, fromAccount, toAccount, amount str
 	return txResult.TxHash, nil
 }
 
+// BankBalances returns the balances of address.
+func (r Runner) BankBalances(ctx context.Context, address string) (sdk.Coins, error) {
+	b := newBuffer()
+
+	if err := r.run(ctx, runOptions{stdout: b}, r.chainCmd.BankBalancesCommand(address)); err != nil {
+		return nil, err
+	}
+
+	data, err := b.JSONEnsuredBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var out struct {
+		Balances []struct {
+			Denom  string `json:"denom"`
+			Amount string `json:"amount"`
+		} `json:"balances"`
+	}
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+
+	var balances sdk.Coins
+	for _, c := range out.Balances {
+		coin, err := sdk.ParseCoinNormalized(c.Amount + c.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = balances.Add(coin)
+	}
+
+	return balances, nil
+}
+
 // WaitTx waits until a tx is successfully added to a block and can be queried
 func (r Runner) WaitTx(ctx context.Context, txHash string, retryDelay time.Duration, maxRetry int) error {
 	retry := 0
@@ -250,6 +250,12 @@ func (s *Session) handleEvents() {
 		case events.IndicationStart:
 			s.StartSpinner(e.String())
 		case events.IndicationUpdate:
+			if s.spinner == nil {
+				// When the spinner is not started by an event first
+				s.StartSpinner(e.String())
+				continue
+			}
+
 			s.spinner.SetText(e.String())
 		case events.IndicationFinish:
 			s.StopSpinner()
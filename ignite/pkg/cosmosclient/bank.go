@@ -39,3 +39,24 @@ func (c Client) BankSendTx(ctx context.Context, fromAccount cosmosaccount.Accoun
 
 	return c.CreateTx(ctx, fromAccount, msg)
 }
+
+// BankMultiSendTx creates a tx that sends coins from fromAccount to many accounts.
+// The total amount sent is the sum of the outputs.
+func (c Client) BankMultiSendTx(ctx context.Context, fromAccount cosmosaccount.Account, outputs []banktypes.Output) (TxService, error) {
+	addr, err := fromAccount.Address(c.addressPrefix)
+	if err != nil {
+		return TxService{}, err
+	}
+
+	var total sdk.Coins
+	for _, o := range outputs {
+		total = total.Add(o.Coins...)
+	}
+
+	msg := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{{Address: addr, Coins: total}},
+		Outputs: outputs,
+	}
+
+	return c.CreateTx(ctx, fromAccount, msg)
+}
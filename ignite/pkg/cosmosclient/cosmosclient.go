@@ -62,6 +62,9 @@ const (
 
 	defaultTXsPerPage = 30
 
+	defaultCollectWorkers = 4
+	defaultCollectRetries = 3
+
 	searchHeight = "tx.height"
 
 	orderAsc = "asc"
@@ -128,6 +131,9 @@ type Client struct {
 	gasPrices    string
 	fees         string
 	generateOnly bool
+
+	collectWorkers int
+	collectRetries uint64
 }
 
 // Option configures your client.
@@ -220,6 +226,22 @@ func WithGenerateOnly(generateOnly bool) Option {
 	}
 }
 
+// WithCollectWorkers sets the number of blocks that are fetched concurrently when
+// transactions are collected. By default four blocks are fetched concurrently.
+func WithCollectWorkers(n int) Option {
+	return func(c *Client) {
+		c.collectWorkers = n
+	}
+}
+
+// WithCollectRetries sets the number of times that fetching a block is retried
+// when transactions are collected. By default it is retried three times.
+func WithCollectRetries(n uint64) Option {
+	return func(c *Client) {
+		c.collectRetries = n
+	}
+}
+
 // WithRPCClient sets a tendermint RPC client.
 // Already set by default.
 func WithRPCClient(rpc rpcclient.Client) Option {
@@ -279,6 +301,8 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		faucetMinAmount: defaultFaucetMinAmount,
 		out:             io.Discard,
 		gas:             strconv.Itoa(defaultGasLimit),
+		collectWorkers:  defaultCollectWorkers,
+		collectRetries:  defaultCollectRetries,
 	}
 
 	var err error
@@ -596,11 +620,26 @@ func (c Client) CreateTx(goCtx context.Context, account cosmosaccount.Account, m
 	}, nil
 }
 
+// GetBlockHash returns the hash of a block.
+func (c Client) GetBlockHash(ctx context.Context, height int64) (string, error) {
+	if height == 0 {
+		return "", ErrInvalidBlockHeight
+	}
+
+	r, err := c.RPC.Block(ctx, &height)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch block %d: %w", height, err)
+	}
+
+	return r.BlockID.Hash.String(), nil
+}
+
 // GetBlockTXs returns the transactions in a block.
 // The list of transactions can be empty if there are no transactions in the block
 // at the moment this method is called.
 // Tendermint might index a limited number of block so trying to fetch transactions
 // from a block that is not indexed would return an error.
+// Transactions that can't be decoded are returned without memo, fees and messages.
 func (c Client) GetBlockTXs(ctx context.Context, height int64) (txs []TX, err error) {
 	if height == 0 {
 		return nil, ErrInvalidBlockHeight
@@ -623,11 +662,19 @@ func (c Client) GetBlockTXs(ctx context.Context, height int64) (txs []TX, err er
 			return nil, err
 		}
 
-		for _, tx := range res.Txs {
-			txs = append(txs, TX{
-				BlockTime: blockTime,
-				Raw:       tx,
-			})
+		for _, rtx := range res.Txs {
+			tx := TX{
+				BlockTime:     blockTime,
+				BlockHash:     r.BlockID.Hash.String(),
+				BlockProposer: r.Block.ProposerAddress.String(),
+				Raw:           rtx,
+			}
+
+			// Proposers can include transactions that can't be decoded, the chain indexes
+			// them with an error code so they are kept without memo, fees and messages
+			_ = decodeTX(&tx, c.context.InterfaceRegistry, c.addressPrefix)
+
+			txs = append(txs, tx)
 		}
 
 		// Stop when the last page is fetched
@@ -644,8 +691,10 @@ func (c Client) GetBlockTXs(ctx context.Context, height int64) (txs []TX, err er
 // CollectTXs collects transactions from multiple consecutive blocks.
 // Transactions from a single block are send to the channel only if all transactions
 // from that block are collected successfully.
-// Blocks are traversed sequentially starting from a height until the latest block height
-// available at the moment this method is called.
+// Blocks are fetched concurrently starting from a height until the latest block height
+// available at the moment this method is called, but their transactions are always
+// sent to the channel sequentially in block height order.
+// Fetching a block is retried with an exponential backoff when it fails.
 // The channel might contain the transactions collected successfully up until that point
 // when an error is returned.
 func (c Client) CollectTXs(ctx context.Context, fromHeight int64, tc chan<- []TX) error {
@@ -660,27 +709,121 @@ func (c Client) CollectTXs(ctx context.Context, fromHeight int64, tc chan<- []TX
 		fromHeight = 1
 	}
 
-	for height := fromHeight; height <= latestHeight; height++ {
-		txs, err := c.GetBlockTXs(ctx, height)
-		if err != nil {
-			return err
+	workers := c.collectWorkers
+	if workers < 1 {
+		workers = 1
+	}
+
+	// Wait for the workers after canceling the collection so there are no
+	// running requests once the collection finishes.
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	collectCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// The result of each block is queued in block height order before the block
+	// is fetched, which allows sending the transactions sequentially while the
+	// number of queued results limits how many blocks are fetched ahead.
+	var (
+		jobs    = make(chan blockJob)
+		results = make(chan chan blockResult, workers)
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(jobs)
+		defer close(results)
+
+		for height := fromHeight; height <= latestHeight; height++ {
+			job := blockJob{height, make(chan blockResult, 1)}
+
+			select {
+			case <-collectCtx.Done():
+				return
+			case results <- job.result:
+			}
+
+			select {
+			case <-collectCtx.Done():
+				return
+			case jobs <- job:
+			}
+		}
+	}()
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for job := range jobs {
+				txs, err := c.getBlockTXsWithRetry(collectCtx, job.height)
+				job.result <- blockResult{txs, err}
+			}
+		}()
+	}
+
+	for result := range results {
+		var r blockResult
+
+		select {
+		case <-collectCtx.Done():
+			return collectCtx.Err()
+		case r = <-result:
+		}
+
+		if r.err != nil {
+			return r.err
 		}
 
 		// Ignore blocks without transactions
-		if txs == nil {
+		if r.txs == nil {
 			continue
 		}
 
 		// Make sure that collection finishes if the context
 		// is done when the transactions channel is full
 		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case tc <- txs:
+		case <-collectCtx.Done():
+			return collectCtx.Err()
+		case tc <- r.txs:
 		}
 	}
 
-	return nil
+	// Results are only closed early when the context is done
+	return collectCtx.Err()
+}
+
+// blockJob defines a block to fetch when transactions are collected.
+type blockJob struct {
+	height int64
+	result chan blockResult
+}
+
+// blockResult defines the result of fetching a block when transactions are collected.
+type blockResult struct {
+	txs []TX
+	err error
+}
+
+// getBlockTXsWithRetry fetches the transactions of a block retrying when it fails.
+// The requests and the retries stop when the context is done, so the pending
+// requests are canceled as soon as the collection stops.
+func (c Client) getBlockTXsWithRetry(ctx context.Context, height int64) (txs []TX, err error) {
+	// Zero max retries means retrying forever so a stop backoff is used instead
+	var b backoff.BackOff = &backoff.StopBackOff{}
+	if c.collectRetries > 0 {
+		b = backoff.WithMaxRetries(backoff.NewExponentialBackOff(), c.collectRetries)
+	}
+
+	err = backoff.Retry(func() (err error) {
+		txs, err = c.GetBlockTXs(ctx, height)
+		return err
+	}, backoff.WithContext(b, ctx))
+
+	return txs, err
 }
 
 // makeSureAccountHasTokens makes sure the address has a positive balance
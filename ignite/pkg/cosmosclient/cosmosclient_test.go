@@ -11,13 +11,20 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/p2p"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -745,6 +752,128 @@ func TestGetBlockTXsWithSearchError(t *testing.T) {
 	m.AssertNumberOfCalls(t, "TxSearch", 1)
 }
 
+func TestGetBlockHash(t *testing.T) {
+	ctx := context.Background()
+	block := createTestBlock(1)
+	blockID := tmtypes.BlockID{Hash: []byte{4, 5, 6}}
+
+	client := newClient(t, func(s suite) {
+		s.rpcClient.EXPECT().
+			Block(ctx, &block.Height).
+			Return(&ctypes.ResultBlock{BlockID: blockID, Block: &block}, nil)
+	})
+
+	// Act
+	hash, err := client.GetBlockHash(ctx, block.Height)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "040506", hash)
+}
+
+func TestGetBlockTXsDecodesTXs(t *testing.T) {
+	ctx := context.Background()
+
+	// Arrange: Create a signed transaction with a bank send message
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(interfaceRegistry), authtx.DefaultSignModes)
+	pk := secp256k1.GenPrivKey().PubKey()
+	msg := &banktypes.MsgSend{
+		FromAddress: "from",
+		ToAddress:   "to",
+		Amount:      sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 1)),
+	}
+	fee := sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 10))
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msg))
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey: pk,
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+	}))
+	builder.SetMemo("memo")
+	builder.SetFeeAmount(fee)
+
+	txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	// Arrange: Mock the RPC endpoints to return the transaction
+	block := createTestBlock(1)
+	block.ProposerAddress = []byte{1, 2, 3}
+	blockID := tmtypes.BlockID{Hash: []byte{4, 5, 6}}
+	rtx := ctypes.ResultTx{Height: block.Height, Tx: txBytes}
+	page := 1
+	perPage := 30
+
+	client := newClient(t, func(s suite) {
+		s.rpcClient.EXPECT().
+			Block(ctx, &block.Height).
+			Return(&ctypes.ResultBlock{BlockID: blockID, Block: &block}, nil)
+		s.rpcClient.EXPECT().
+			TxSearch(ctx, "tx.height=1", false, &page, &perPage, "asc").
+			Return(&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{&rtx}, TotalCount: 1}, nil)
+	})
+
+	signer, err := bech32.ConvertAndEncode("cosmos", pk.Address())
+	require.NoError(t, err)
+
+	// Act
+	txs, err := client.GetBlockTXs(ctx, block.Height)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, "040506", txs[0].BlockHash)
+	require.Equal(t, "010203", txs[0].BlockProposer)
+	require.Equal(t, "memo", txs[0].Memo)
+	require.Equal(t, fee, txs[0].Fee)
+	require.Len(t, txs[0].Messages, 1)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", txs[0].Messages[0].TypeURL)
+	require.Equal(t, []string{signer}, txs[0].Messages[0].Signers)
+	require.JSONEq(
+		t,
+		`{"from_address":"from","to_address":"to","amount":[{"denom":"token","amount":"1"}]}`,
+		string(txs[0].Messages[0].Body),
+	)
+}
+
+func TestGetBlockTXsWithUndecodableTX(t *testing.T) {
+	ctx := context.Background()
+
+	// Arrange: Mock the RPC endpoints to return a transaction with garbage bytes
+	block := createTestBlock(1)
+	rtx := ctypes.ResultTx{
+		Height:   block.Height,
+		Tx:       []byte("garbage"),
+		TxResult: abcitypes.ResponseDeliverTx{Code: 2},
+	}
+	page := 1
+	perPage := 30
+
+	client := newClient(t, func(s suite) {
+		s.rpcClient.EXPECT().
+			Block(ctx, &block.Height).
+			Return(&ctypes.ResultBlock{Block: &block}, nil)
+		s.rpcClient.EXPECT().
+			TxSearch(ctx, "tx.height=1", false, &page, &perPage, "asc").
+			Return(&ctypes.ResultTxSearch{Txs: []*ctypes.ResultTx{&rtx}, TotalCount: 1}, nil)
+	})
+
+	// Act
+	txs, err := client.GetBlockTXs(ctx, block.Height)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, &rtx, txs[0].Raw)
+	require.Empty(t, txs[0].Memo)
+	require.Empty(t, txs[0].Fee)
+	require.Empty(t, txs[0].Messages)
+}
+
 func TestCollectTXs(t *testing.T) {
 	m := testutil.NewTendermintClientMock(t)
 	ctx := context.Background()
@@ -762,8 +891,8 @@ func TestCollectTXs(t *testing.T) {
 	b1 := createTestBlock(1)
 	b2 := createTestBlock(2)
 
-	m.On("Block", ctx, &b1.Height).Return(&ctypes.ResultBlock{Block: &b1}, nil)
-	m.On("Block", ctx, &b2.Height).Return(&ctypes.ResultBlock{Block: &b2}, nil)
+	m.On("Block", mock.Anything, &b1.Height).Return(&ctypes.ResultBlock{Block: &b1}, nil)
+	m.On("Block", mock.Anything, &b2.Height).Return(&ctypes.ResultBlock{Block: &b2}, nil)
 
 	// Mock the TxSearch RPC endpoint to return each of the two block.
 	// Transactions are empty because only the pointer address is required to assert.
@@ -780,8 +909,8 @@ func TestCollectTXs(t *testing.T) {
 		TotalCount: 2,
 	}
 
-	m.On("TxSearch", ctx, q1, false, &page, &perPage, "asc").Return(&r1, nil)
-	m.On("TxSearch", ctx, q2, false, &page, &perPage, "asc").Return(&r2, nil)
+	m.On("TxSearch", mock.Anything, q1, false, &page, &perPage, "asc").Return(&r1, nil)
+	m.On("TxSearch", mock.Anything, q2, false, &page, &perPage, "asc").Return(&r2, nil)
 
 	// Prepare expected values
 	wantTXs := []cosmosclient.TX{
@@ -840,6 +969,107 @@ func TestCollectTXs(t *testing.T) {
 	require.False(t, open, "expected transaction channel to be closed")
 }
 
+func TestCollectTXsWithWorkers(t *testing.T) {
+	// Arrange
+	var (
+		latestHeight int64 = 5
+		ctx                = context.Background()
+		perPage            = 30
+		wantHeights  []int64
+	)
+
+	client := newClient(t, func(s suite) {
+		s.rpcClient.EXPECT().
+			Status(mock.Anything).
+			Return(&ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: latestHeight}}, nil).
+			Once()
+
+		for height := int64(1); height <= latestHeight; height++ {
+			h := height
+			block := createTestBlock(h)
+			page := 1
+			res := ctypes.ResultTxSearch{
+				Txs:        []*ctypes.ResultTx{{Height: h}},
+				TotalCount: 1,
+			}
+
+			// Fail the first request for the second block to check that it is retried
+			if h == 2 {
+				s.rpcClient.EXPECT().
+					Block(mock.Anything, &h).
+					Return(nil, errors.New("connection refused")).
+					Once()
+			}
+
+			s.rpcClient.EXPECT().
+				Block(mock.Anything, &h).
+				Return(&ctypes.ResultBlock{Block: &block}, nil).
+				Once()
+			s.rpcClient.EXPECT().
+				TxSearch(mock.Anything, fmt.Sprintf("tx.height=%d", h), false, &page, &perPage, "asc").
+				Return(&res, nil).
+				Once()
+
+			wantHeights = append(wantHeights, h)
+		}
+	}, cosmosclient.WithCollectWorkers(3), cosmosclient.WithCollectRetries(1))
+
+	tc := make(chan []cosmosclient.TX)
+
+	var heights []int64
+
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+
+		for txs := range tc {
+			heights = append(heights, txs[0].Raw.Height)
+		}
+	}()
+
+	// Act
+	err := client.CollectTXs(ctx, 1, tc)
+	<-finished
+
+	// Assert: blocks are received in height order
+	require.NoError(t, err)
+	require.Equal(t, wantHeights, heights)
+}
+
+func TestCollectTXsCancelsPendingRequests(t *testing.T) {
+	// Arrange
+	var (
+		latestHeight int64 = 2
+		wantErr            = errors.New("expected error")
+	)
+
+	client := newClient(t, func(s suite) {
+		s.rpcClient.EXPECT().
+			Status(mock.Anything).
+			Return(&ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: latestHeight}}, nil).
+			Once()
+
+		// The first block fails while the request for the second one is pending
+		h1, h2 := int64(1), int64(2)
+		s.rpcClient.EXPECT().
+			Block(mock.Anything, &h1).
+			Return(nil, wantErr)
+		s.rpcClient.EXPECT().
+			Block(mock.Anything, &h2).
+			Run(func(ctx context.Context, _ *int64) { <-ctx.Done() }).
+			Return(nil, context.Canceled).
+			Maybe()
+	}, cosmosclient.WithCollectWorkers(2), cosmosclient.WithCollectRetries(1))
+
+	tc := make(chan []cosmosclient.TX)
+
+	// Act
+	err := client.CollectTXs(context.Background(), 1, tc)
+
+	// Assert: the pending request is canceled and doesn't block the collection
+	require.ErrorIs(t, err, wantErr)
+}
+
 func TestCollectTXsWithStatusError(t *testing.T) {
 	m := testutil.NewTendermintClientMock(t)
 
@@ -5,6 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
@@ -13,10 +19,39 @@ type TX struct {
 	// BlockTime returns the time of the block that contains the transaction.
 	BlockTime time.Time
 
+	// BlockHash contains the hash of the block that contains the transaction.
+	BlockHash string
+
+	// BlockProposer contains the address of the validator that proposed the block.
+	BlockProposer string
+
+	// Memo contains the transaction memo.
+	Memo string
+
+	// Fee contains the fees paid by the transaction.
+	Fee sdktypes.Coins
+
+	// Messages contains the decoded transaction messages.
+	Messages []TXMessage
+
 	// Raw contains the transaction as returned by the Tendermint API.
 	Raw *ctypes.ResultTx
 }
 
+// TXMessage defines a transaction message.
+type TXMessage struct {
+	// TypeURL contains the type URL of the message, for example "/cosmos.bank.v1beta1.MsgSend".
+	TypeURL string `json:"type_url"`
+
+	// Body contains the JSON encoded message.
+	// Messages with types unknown to the client are encoded as an object
+	// with the type URL and the base64 encoded protobuf message value.
+	Body json.RawMessage `json:"body"`
+
+	// Signers contains the addresses of the accounts that signed the transaction.
+	Signers []string `json:"signers"`
+}
+
 // GetEvents returns the transaction events.
 func (t TX) GetEvents() (events []TXEvent, err error) {
 	for _, e := range t.Raw.TxResult.Events {
@@ -63,3 +98,93 @@ func formatAttributeValue(v []byte) ([]byte, error) {
 	// Encode all string or invalid values
 	return json.Marshal(string(v))
 }
+
+// decodeTX decodes the memo, fees and messages of a Cosmos SDK transaction.
+// The signers are the accounts of the public keys included in the transaction.
+// The transaction is left unchanged when it can't be decoded.
+func decodeTX(t *TX, registry codectypes.InterfaceRegistry, addressPrefix string) error {
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(t.Raw.Tx); err != nil {
+		return err
+	}
+
+	var body txtypes.TxBody
+	if err := body.Unmarshal(raw.BodyBytes); err != nil {
+		return err
+	}
+
+	var authInfo txtypes.AuthInfo
+	if err := authInfo.Unmarshal(raw.AuthInfoBytes); err != nil {
+		return err
+	}
+
+	signers, err := decodeSigners(authInfo.SignerInfos, registry, addressPrefix)
+	if err != nil {
+		return err
+	}
+
+	var messages []TXMessage
+	for _, m := range body.Messages {
+		data, err := encodeMessageJSON(m, registry)
+		if err != nil {
+			return fmt.Errorf("error encoding message '%s': %w", m.TypeUrl, err)
+		}
+
+		messages = append(messages, TXMessage{
+			TypeURL: m.TypeUrl,
+			Body:    data,
+			Signers: signers,
+		})
+	}
+
+	t.Memo = body.Memo
+	t.Messages = messages
+
+	if authInfo.Fee != nil {
+		t.Fee = authInfo.Fee.Amount
+	}
+
+	return nil
+}
+
+func decodeSigners(infos []*txtypes.SignerInfo, registry codectypes.InterfaceRegistry, addressPrefix string) (signers []string, err error) {
+	if registry == nil {
+		return nil, nil
+	}
+
+	for _, info := range infos {
+		// Public keys are optional for accounts that already exist in the chain state
+		if info.PublicKey == nil {
+			continue
+		}
+
+		var pk cryptotypes.PubKey
+		if err := registry.UnpackAny(info.PublicKey, &pk); err != nil {
+			continue
+		}
+
+		addr, err := bech32.ConvertAndEncode(addressPrefix, pk.Address())
+		if err != nil {
+			return nil, err
+		}
+
+		signers = append(signers, addr)
+	}
+
+	return signers, nil
+}
+
+func encodeMessageJSON(m *codectypes.Any, registry codectypes.InterfaceRegistry) ([]byte, error) {
+	if registry != nil {
+		var msg sdktypes.Msg
+		if err := registry.UnpackAny(m, &msg); err == nil {
+			return codec.ProtoMarshalJSON(msg, registry)
+		}
+	}
+
+	// Messages defined by the chain modules are unknown to the client
+	return json.Marshal(struct {
+		TypeURL string `json:"@type"`
+		Value   []byte `json:"value"`
+	}{m.TypeUrl, m.Value})
+}
@@ -0,0 +1,101 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+)
+
+// Backend is used by the faucet to interact with the chain.
+type Backend interface {
+	// ChainID returns the id of the chain.
+	ChainID(ctx context.Context) (string, error)
+
+	// ImportAccount imports an account from its mnemonic.
+	// It must not fail when the account already exists.
+	ImportAccount(ctx context.Context, name, mnemonic, coinType string) error
+
+	// AccountAddress returns the address of the account with name.
+	AccountAddress(ctx context.Context, name string) (string, error)
+
+	// Send sends coins from the account with name to toAddress, waits for the
+	// transaction to be confirmed and returns its hash.
+	Send(ctx context.Context, fromName, toAddress string, coins sdk.Coins) (txHash string, err error)
+
+	// Balances returns the balances of the account with address.
+	Balances(ctx context.Context, address string) (sdk.Coins, error)
+}
+
+// RunnerBackend is a faucet backend that uses the chain's binary.
+type RunnerBackend struct {
+	runner chaincmdrunner.Runner
+}
+
+// NewRunnerBackend creates a new faucet backend with ccr (to access and use blockchain's CLI).
+func NewRunnerBackend(ccr chaincmdrunner.Runner) RunnerBackend {
+	return RunnerBackend{ccr}
+}
+
+// ChainID implements Backend.
+func (b RunnerBackend) ChainID(ctx context.Context) (string, error) {
+	status, err := b.runner.Status(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return status.ChainID, nil
+}
+
+// ImportAccount implements Backend.
+func (b RunnerBackend) ImportAccount(ctx context.Context, name, mnemonic, coinType string) error {
+	_, err := b.runner.AddAccount(ctx, name, mnemonic, coinType)
+	if err != nil && err != chaincmdrunner.ErrAccountAlreadyExists {
+		return err
+	}
+
+	return nil
+}
+
+// AccountAddress implements Backend.
+func (b RunnerBackend) AccountAddress(ctx context.Context, name string) (string, error) {
+	account, err := b.runner.ShowAccount(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	return account.Address, nil
+}
+
+// Send implements Backend.
+func (b RunnerBackend) Send(ctx context.Context, fromName, toAddress string, coins sdk.Coins) (string, error) {
+	fromAddress, err := b.AccountAddress(ctx, fromName)
+	if err != nil {
+		return "", err
+	}
+
+	var coinsStr []string
+	for _, c := range coins {
+		coinsStr = append(coinsStr, c.String())
+	}
+
+	txHash, err := b.runner.BankSend(ctx, fromAddress, toAddress, strings.Join(coinsStr, ","))
+	if err != nil {
+		return "", err
+	}
+
+	// wait for the send tx to be confirmed
+	if err := b.runner.WaitTx(ctx, txHash, time.Second, 30); err != nil {
+		return "", err
+	}
+
+	return txHash, nil
+}
+
+// Balances implements Backend.
+func (b RunnerBackend) Balances(ctx context.Context, address string) (sdk.Coins, error) {
+	return b.runner.BankBalances(ctx, address)
+}
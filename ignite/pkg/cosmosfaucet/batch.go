@@ -0,0 +1,172 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultBatchMaxSize is the default max. number of transfers sent in a single transaction.
+	DefaultBatchMaxSize = 100
+
+	// batchSendTimeout is the time to wait for a batch transaction to be confirmed.
+	batchSendTimeout = time.Minute
+)
+
+// Output is the coins sent to a single account in a batch transaction.
+type Output struct {
+	// Address of the account that receives the coins.
+	Address string
+
+	// Coins to send.
+	Coins sdk.Coins
+}
+
+// MultiSender sends coins from an account to many accounts in a single transaction.
+type MultiSender interface {
+	// MultiSend sends the outputs from the account with name, waits for the
+	// transaction to be confirmed and returns its hash.
+	MultiSend(ctx context.Context, fromName string, outputs []Output) (txHash string, err error)
+}
+
+// batchRequest is a transfer request waiting to be sent in a batch.
+type batchRequest struct {
+	output Output
+	result chan batchResult
+}
+
+type batchResult struct {
+	txHash string
+	err    error
+}
+
+// batcher collects transfer requests during a time window and sends them
+// in a single multi-send transaction.
+type batcher struct {
+	sender      MultiSender
+	ledger      Ledger
+	accountName string
+	window      time.Duration
+	maxSize     int
+
+	// sendMu keeps batches from being sent concurrently to avoid account sequence mismatches.
+	sendMu sync.Mutex
+
+	mu    sync.Mutex
+	queue []*batchRequest
+	timer *time.Timer
+
+	// pending holds the coins of the queued and the in-flight transfers per account.
+	// they are not recorded in the ledger yet but must be accounted to enforce the limits.
+	pending map[string]sdk.Coins
+}
+
+func newBatcher(sender MultiSender, ledger Ledger, accountName string, window time.Duration, maxSize int) *batcher {
+	return &batcher{
+		sender:      sender,
+		ledger:      ledger,
+		accountName: accountName,
+		window:      window,
+		maxSize:     maxSize,
+		pending:     make(map[string]sdk.Coins),
+	}
+}
+
+// transfer queues a transfer and waits until its batch is sent.
+// The limits of the faucet are checked against the ledger and the pending transfers.
+// Once queued, a transfer is sent even if ctx is canceled.
+func (b *batcher) transfer(ctx context.Context, f Faucet, toAccountAddress string, coins sdk.Coins) (string, error) {
+	b.mu.Lock()
+
+	if err := f.checkLimits(ctx, toAccountAddress, coins, b.pending[toAccountAddress]); err != nil {
+		b.mu.Unlock()
+		return "", err
+	}
+
+	req := &batchRequest{
+		output: Output{
+			Address: toAccountAddress,
+			Coins:   coins,
+		},
+		result: make(chan batchResult, 1),
+	}
+
+	b.queue = append(b.queue, req)
+	b.pending[toAccountAddress] = b.pending[toAccountAddress].Add(coins...)
+
+	switch {
+	case len(b.queue) >= b.maxSize:
+		if b.timer != nil {
+			b.timer.Stop()
+		}
+		go b.flush()
+	case len(b.queue) == 1:
+		b.timer = time.AfterFunc(b.window, b.flush)
+	}
+
+	b.mu.Unlock()
+
+	select {
+	case r := <-req.result:
+		return r.txHash, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// flush sends the queued transfers and notifies the result to the waiting requests.
+func (b *batcher) flush() {
+	b.mu.Lock()
+	queue := b.queue
+	b.queue = nil
+	b.mu.Unlock()
+
+	if len(queue) == 0 {
+		return
+	}
+
+	b.sendMu.Lock()
+	defer b.sendMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), batchSendTimeout)
+	defer cancel()
+
+	outputs := make([]Output, len(queue))
+	for i, req := range queue {
+		outputs[i] = req.output
+	}
+
+	txHash, err := b.sender.MultiSend(ctx, b.accountName, outputs)
+
+	results := make([]batchResult, len(queue))
+	for i, req := range queue {
+		results[i] = batchResult{txHash, err}
+
+		if err == nil {
+			results[i].err = b.ledger.Record(ctx, TransferRecord{
+				Address: req.output.Address,
+				Coins:   req.output.Coins,
+				Time:    time.Now(),
+			})
+		}
+	}
+
+	// release the pending coins only after they are recorded in the ledger.
+	b.mu.Lock()
+	for _, req := range queue {
+		address := req.output.Address
+
+		b.pending[address] = b.pending[address].Sub(req.output.Coins...)
+		if b.pending[address].IsZero() {
+			delete(b.pending, address)
+		}
+	}
+	b.mu.Unlock()
+
+	for i, req := range queue {
+		req.result <- results[i]
+	}
+}
@@ -0,0 +1,92 @@
+package cosmosfaucet_test
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+)
+
+type multiSender struct {
+	mu      sync.Mutex
+	batches [][]cosmosfaucet.Output
+}
+
+func (s *multiSender) MultiSend(_ context.Context, _ string, outputs []cosmosfaucet.Output) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.batches = append(s.batches, outputs)
+	return "HASH", nil
+}
+
+func TestBatchTransfer(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		sender = &multiSender{}
+		coins  = sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+	)
+
+	storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "faucet.db"))
+	require.NoError(t, err)
+
+	ledger := cosmosfaucet.NewEmbeddedLedger(storage, "test-1")
+
+	f, err := cosmosfaucet.New(
+		ctx,
+		chaincmdrunner.Runner{},
+		cosmosfaucet.ChainID("test-1"),
+		cosmosfaucet.Coin(10, 15, "token"),
+		cosmosfaucet.WithLedger(ledger),
+		cosmosfaucet.Batch(sender, time.Millisecond*100, 0),
+	)
+	require.NoError(t, err)
+
+	// Act: transfer concurrently to two accounts, one of them twice
+	addresses := []string{"cosmos1a", "cosmos1b", "cosmos1a"}
+	hashes := make([]string, len(addresses))
+	errs := make([]error, len(addresses))
+
+	var wg sync.WaitGroup
+	for i, addr := range addresses {
+		i, addr := i, addr
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			hashes[i], errs[i] = f.Transfer(ctx, addr, coins)
+		}()
+
+		// keep the requests order
+		time.Sleep(time.Millisecond * 10)
+	}
+	wg.Wait()
+
+	// Assert: transfers are sent in a single tx and pending ones count for the limits
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.Error(t, errs[2])
+	require.Equal(t, "HASH", hashes[0])
+	require.Equal(t, "HASH", hashes[1])
+	require.Len(t, sender.batches, 1)
+	require.Equal(t, []cosmosfaucet.Output{
+		{Address: "cosmos1a", Coins: coins},
+		{Address: "cosmos1b", Coins: coins},
+	}, sender.batches[0])
+
+	// Assert: the transfers are recorded in the ledger
+	total, err := f.TotalTransferredAmount(ctx, "cosmos1a", "token")
+	require.NoError(t, err)
+	require.EqualValues(t, 10, total)
+
+	_, err = f.Transfer(ctx, "cosmos1a", coins)
+	require.Error(t, err)
+}
@@ -0,0 +1,179 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultChallengeTTL is the default time that a challenge can be solved in.
+const DefaultChallengeTTL = time.Minute * 5
+
+var (
+	// ErrChallengeRequired is returned when a transfer request doesn't include
+	// a solved challenge and the faucet requires it.
+	ErrChallengeRequired = errors.New("a solved challenge is required, request one at /challenge")
+
+	// ErrInvalidChallenge is returned when a challenge was not issued by the faucet,
+	// has expired or was already used.
+	ErrInvalidChallenge = errors.New("invalid or expired challenge")
+
+	// ErrInvalidChallengeSolution is returned when the nonce doesn't solve the challenge.
+	ErrInvalidChallengeSolution = errors.New("nonce doesn't solve the challenge")
+)
+
+// challenger issues and verifies hashcash-style proof-of-work challenges.
+// A challenge is solved by finding a nonce that makes the SHA-256 hash of
+// "challenge:address:nonce" start with at least difficulty zero bits.
+//
+// Challenges are signed with a secret so they don't need to be stored until
+// they are solved, only the used ones are kept until they expire to avoid replays.
+type challenger struct {
+	difficulty uint
+	ttl        time.Duration
+	secret     []byte
+
+	mu   sync.Mutex
+	used map[string]time.Time
+}
+
+func newChallenger(difficulty uint, ttl time.Duration) (*challenger, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	return &challenger{
+		difficulty: difficulty,
+		ttl:        ttl,
+		secret:     secret,
+		used:       make(map[string]time.Time),
+	}, nil
+}
+
+// New issues a new challenge that expires after the challenger's TTL.
+func (c *challenger) New(now time.Time) (ChallengeResponse, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return ChallengeResponse{}, err
+	}
+
+	expiresAt := now.Add(c.ttl)
+	payload := fmt.Sprintf("%s.%d", hex.EncodeToString(random), expiresAt.Unix())
+
+	return ChallengeResponse{
+		Challenge:  fmt.Sprintf("%s.%s", payload, c.sign(payload)),
+		Difficulty: c.difficulty,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
+// Verify checks that the challenge was issued by the challenger, is not expired
+// and nonce solves it for address. A challenge can only be verified once.
+func (c *challenger) Verify(challenge, address, nonce string, now time.Time) error {
+	if challenge == "" || nonce == "" {
+		return ErrChallengeRequired
+	}
+
+	i := strings.LastIndex(challenge, ".")
+	if i == -1 {
+		return ErrInvalidChallenge
+	}
+
+	payload, signature := challenge[:i], challenge[i+1:]
+	if !hmac.Equal([]byte(signature), []byte(c.sign(payload))) {
+		return ErrInvalidChallenge
+	}
+
+	j := strings.LastIndex(payload, ".")
+	if j == -1 {
+		return ErrInvalidChallenge
+	}
+
+	expiry, err := strconv.ParseInt(payload[j+1:], 10, 64)
+	if err != nil {
+		return ErrInvalidChallenge
+	}
+
+	expiresAt := time.Unix(expiry, 0)
+	if now.After(expiresAt) {
+		return ErrInvalidChallenge
+	}
+
+	if !hasLeadingZeroBits(challengeHash(challenge, address, nonce), c.difficulty) {
+		return ErrInvalidChallengeSolution
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, exp := range c.used {
+		if now.After(exp) {
+			delete(c.used, k)
+		}
+	}
+
+	if _, ok := c.used[challenge]; ok {
+		return ErrInvalidChallenge
+	}
+
+	c.used[challenge] = expiresAt
+
+	return nil
+}
+
+func (c *challenger) sign(payload string) string {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SolveChallenge finds a nonce that solves a challenge issued by the faucet for address.
+func SolveChallenge(ctx context.Context, challenge, address string, difficulty uint) (string, error) {
+	for n := uint64(0); ; n++ {
+		// check for cancellation from time to time to not slow down the search.
+		if n%10000 == 0 {
+			if err := ctx.Err(); err != nil {
+				return "", err
+			}
+		}
+
+		nonce := strconv.FormatUint(n, 10)
+		if hasLeadingZeroBits(challengeHash(challenge, address, nonce), difficulty) {
+			return nonce, nil
+		}
+	}
+}
+
+func challengeHash(challenge, address, nonce string) []byte {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s:%s:%s", challenge, address, nonce)))
+	return h[:]
+}
+
+func hasLeadingZeroBits(hash []byte, n uint) bool {
+	for _, b := range hash {
+		if n == 0 {
+			return true
+		}
+
+		if n < 8 {
+			return b>>(8-n) == 0
+		}
+
+		if b != 0 {
+			return false
+		}
+
+		n -= 8
+	}
+
+	return n == 0
+}
@@ -0,0 +1,70 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChallenger(t *testing.T) {
+	var (
+		address = "cosmos1p8d9e0dc2xcrk6f6qrmvxxz6vq6f4t7l0p5ls0"
+		now     = time.Now()
+	)
+
+	c, err := newChallenger(8, time.Minute)
+	require.NoError(t, err)
+
+	challenge, err := c.New(now)
+	require.NoError(t, err)
+	require.EqualValues(t, 8, challenge.Difficulty)
+
+	nonce, err := SolveChallenge(context.Background(), challenge.Challenge, address, challenge.Difficulty)
+	require.NoError(t, err)
+
+	// Assert: the solution is bound to the address
+	require.ErrorIs(t, c.Verify(challenge.Challenge, "cosmos1other", nonce, now), ErrInvalidChallengeSolution)
+
+	// Assert: a challenge can only be used once
+	require.NoError(t, c.Verify(challenge.Challenge, address, nonce, now))
+	require.ErrorIs(t, c.Verify(challenge.Challenge, address, nonce, now), ErrInvalidChallenge)
+
+	// Assert: expired and forged challenges are rejected
+	challenge, err = c.New(now)
+	require.NoError(t, err)
+	nonce, err = SolveChallenge(context.Background(), challenge.Challenge, address, challenge.Difficulty)
+	require.NoError(t, err)
+	require.ErrorIs(t, c.Verify(challenge.Challenge, address, nonce, now.Add(time.Hour)), ErrInvalidChallenge)
+	require.ErrorIs(t, c.Verify(challenge.Challenge+"0", address, nonce, now), ErrInvalidChallenge)
+	require.ErrorIs(t, c.Verify("", address, "", now), ErrChallengeRequired)
+}
+
+func TestHasLeadingZeroBits(t *testing.T) {
+	require.True(t, hasLeadingZeroBits([]byte{0x00, 0x0f}, 12))
+	require.False(t, hasLeadingZeroBits([]byte{0x00, 0x1f}, 12))
+	require.True(t, hasLeadingZeroBits([]byte{0x7f}, 1))
+	require.True(t, hasLeadingZeroBits([]byte{0xff}, 0))
+	require.False(t, hasLeadingZeroBits([]byte{0x00}, 9))
+}
+
+func TestRateLimiter(t *testing.T) {
+	var (
+		l   = newRateLimiter(2, time.Minute)
+		now = time.Now()
+	)
+
+	require.True(t, l.Allow("a", now))
+	require.True(t, l.Allow("a", now))
+	require.False(t, l.Allow("a", now))
+	require.True(t, l.Allow("b", now))
+
+	// Assert: a token is restored after period / requests
+	require.True(t, l.Allow("a", now.Add(time.Second*30)))
+	require.False(t, l.Allow("a", now.Add(time.Second*30)))
+
+	// Assert: a nil limiter allows everything
+	var disabled *rateLimiter
+	require.True(t, disabled.Allow("a", now))
+}
@@ -0,0 +1,120 @@
+// Package clientbackend is a faucet backend that signs and broadcasts the
+// transfers with a cosmos client, so the faucet can run against a remote
+// node without the chain's binary.
+package clientbackend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+)
+
+// defaultCoinType is the only coin type supported to import accounts.
+const defaultCoinType = "118"
+
+var (
+	_ cosmosfaucet.Backend     = Backend{}
+	_ cosmosfaucet.MultiSender = Backend{}
+)
+
+// Backend is a faucet backend that uses a cosmos client and the accounts
+// of its registry to send the transfers.
+type Backend struct {
+	client cosmosclient.Client
+}
+
+// New creates a new faucet backend that uses client to interact with the chain.
+func New(client cosmosclient.Client) Backend {
+	return Backend{client}
+}
+
+// ChainID implements cosmosfaucet.Backend.
+func (b Backend) ChainID(ctx context.Context) (string, error) {
+	status, err := b.client.Status(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return status.NodeInfo.Network, nil
+}
+
+// ImportAccount implements cosmosfaucet.Backend.
+// Only the default coin type (118) is supported.
+func (b Backend) ImportAccount(_ context.Context, name, mnemonic, coinType string) error {
+	if coinType != "" && coinType != defaultCoinType {
+		return fmt.Errorf("unsupported coin type %s for the faucet account", coinType)
+	}
+
+	_, err := b.client.AccountRegistry.Import(name, mnemonic, "")
+	if err != nil && !errors.Is(err, cosmosaccount.ErrAccountExists) {
+		return err
+	}
+
+	return nil
+}
+
+// AccountAddress implements cosmosfaucet.Backend.
+func (b Backend) AccountAddress(_ context.Context, name string) (string, error) {
+	return b.client.Address(name)
+}
+
+// Send implements cosmosfaucet.Backend.
+func (b Backend) Send(ctx context.Context, fromName, toAddress string, coins sdk.Coins) (string, error) {
+	account, err := b.client.Account(fromName)
+	if err != nil {
+		return "", err
+	}
+
+	txService, err := b.client.BankSendTx(ctx, account, toAddress, coins)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := txService.Broadcast(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return res.TxHash, nil
+}
+
+// MultiSend implements cosmosfaucet.MultiSender.
+func (b Backend) MultiSend(ctx context.Context, fromName string, outputs []cosmosfaucet.Output) (string, error) {
+	account, err := b.client.Account(fromName)
+	if err != nil {
+		return "", err
+	}
+
+	bankOutputs := make([]banktypes.Output, len(outputs))
+	for i, o := range outputs {
+		bankOutputs[i] = banktypes.Output{
+			Address: o.Address,
+			Coins:   o.Coins,
+		}
+	}
+
+	txService, err := b.client.BankMultiSendTx(ctx, account, bankOutputs)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := txService.Broadcast(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return res.TxHash, nil
+}
+
+// Balances implements cosmosfaucet.Backend.
+// Balances are queried with the gRPC bank query client.
+func (b Backend) Balances(ctx context.Context, address string) (sdk.Coins, error) {
+	return b.client.BankBalances(ctx, address, nil)
+}
@@ -3,12 +3,14 @@ package cosmosfaucet
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/events"
 )
 
 const (
@@ -33,8 +35,8 @@ const (
 
 // Faucet represents a faucet.
 type Faucet struct {
-	// runner used to intereact with blockchain's binary to transfer tokens.
-	runner chaincmdrunner.Runner
+	// backend used to interact with the blockchain to transfer tokens.
+	backend Backend
 
 	// chainID is the chain id of the chain that faucet is operating for.
 	chainID string
@@ -57,6 +59,50 @@ type Faucet struct {
 
 	limitRefreshWindow time.Duration
 
+	// ledger keeps track of the transfers made by the faucet to enforce coinsMax.
+	ledger Ledger
+
+	// ipLimiter limits the transfer requests made by a single IP address.
+	ipLimiter *rateLimiter
+
+	// globalLimiter limits the transfer requests served by the faucet.
+	globalLimiter *rateLimiter
+
+	// challengeDifficulty is the number of leading zero bits required to solve
+	// a proof-of-work challenge. challenges are disabled when it's zero.
+	challengeDifficulty uint
+
+	// challengeTTL is the time that a challenge can be solved in.
+	challengeTTL time.Duration
+
+	// challenger issues and verifies the proof-of-work challenges.
+	challenger *challenger
+
+	// multiSender sends batched transfers when batching is enabled.
+	multiSender MultiSender
+
+	// batchWindow is the time to collect transfer requests before sending them in a batch.
+	batchWindow time.Duration
+
+	// batchMaxSize is the max. number of transfers sent in a single batch.
+	batchMaxSize int
+
+	// batcher queues the transfer requests when batching is enabled.
+	batcher *batcher
+
+	// metrics keeps the counters exposed by the metrics endpoint.
+	metrics *metrics
+
+	// lowBalanceThreshold are the amounts below which the faucet balance is considered low.
+	lowBalanceThreshold sdk.Coins
+
+	// adminToken is the token required to access the admin endpoints.
+	// admin endpoints are disabled when it's empty.
+	adminToken string
+
+	// ev is used to send events, like when the faucet balance is low.
+	ev events.Bus
+
 	// openAPIData holds template data customizations for serving OpenAPI page & spec.
 	openAPIData openAPIData
 }
@@ -94,6 +140,74 @@ func RefreshWindow(refreshWindow time.Duration) Option {
 	}
 }
 
+// WithLedger sets the ledger used to keep track of the transferred amounts.
+// By default transfers are computed from the tx events of the chain.
+func WithLedger(ledger Ledger) Option {
+	return func(f *Faucet) {
+		f.ledger = ledger
+	}
+}
+
+// IPRateLimit limits the transfer requests that a single IP address can make
+// to the number of requests within period.
+func IPRateLimit(requests int, period time.Duration) Option {
+	return func(f *Faucet) {
+		f.ipLimiter = newRateLimiter(requests, period)
+	}
+}
+
+// GlobalRateLimit limits the transfer requests served by the faucet
+// to the number of requests within period.
+func GlobalRateLimit(requests int, period time.Duration) Option {
+	return func(f *Faucet) {
+		f.globalLimiter = newRateLimiter(requests, period)
+	}
+}
+
+// Challenge requires transfer requests to include a solved proof-of-work challenge.
+// difficulty is the number of leading zero bits of the solution hash and ttl is the
+// time that a challenge can be solved in, DefaultChallengeTTL is used when it's zero.
+func Challenge(difficulty uint, ttl time.Duration) Option {
+	return func(f *Faucet) {
+		f.challengeDifficulty = difficulty
+		f.challengeTTL = ttl
+	}
+}
+
+// Batch enables sending the transfer requests received within window in a single
+// multi-send transaction using sender, which can be the backend when it supports it. A batch is sent earlier when it reaches
+// maxSize transfers, DefaultBatchMaxSize is used when it's zero.
+func Batch(sender MultiSender, window time.Duration, maxSize int) Option {
+	return func(f *Faucet) {
+		f.multiSender = sender
+		f.batchWindow = window
+		f.batchMaxSize = maxSize
+	}
+}
+
+// LowBalance sets the amounts below which the faucet balance is considered low.
+// An event is sent to the event bus each time the balance of a denom goes below its threshold.
+func LowBalance(threshold sdk.Coins) Option {
+	return func(f *Faucet) {
+		f.lowBalanceThreshold = threshold
+	}
+}
+
+// AdminToken enables the admin endpoints, which require token to be sent
+// as a bearer token in the Authorization header.
+func AdminToken(token string) Option {
+	return func(f *Faucet) {
+		f.adminToken = token
+	}
+}
+
+// CollectEvents collects events from the faucet.
+func CollectEvents(ev events.Bus) Option {
+	return func(f *Faucet) {
+		f.ev = ev
+	}
+}
+
 // ChainID adds chain id to faucet. faucet will automatically fetch when it isn't provided.
 func ChainID(id string) Option {
 	return func(f *Faucet) {
@@ -108,13 +222,24 @@ func OpenAPI(apiAddress string) Option {
 	}
 }
 
+// ErrLedgerRequired is returned when the faucet backend cannot be used
+// to compute the transferred amounts and no ledger is provided.
+var ErrLedgerRequired = errors.New("a ledger is required to keep track of the faucet transfers")
+
 // New creates a new faucet with ccr (to access and use blockchain's CLI) and given options.
 func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Faucet, error) {
+	return NewWithBackend(ctx, NewRunnerBackend(ccr), options...)
+}
+
+// NewWithBackend creates a new faucet that uses backend to interact with the blockchain.
+// A ledger must be provided with WithLedger unless backend is a RunnerBackend.
+func NewWithBackend(ctx context.Context, backend Backend, options ...Option) (Faucet, error) {
 	f := Faucet{
-		runner:      ccr,
+		backend:     backend,
 		accountName: DefaultAccountName,
 		coinsMax:    make(map[string]uint64),
 		openAPIData: openAPIData{"Blockchain", "http://localhost:1317"},
+		metrics:     newMetrics(),
 	}
 
 	for _, apply := range options {
@@ -129,22 +254,51 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 		RefreshWindow(DefaultRefreshWindow)(&f)
 	}
 
+	if f.ledger == nil {
+		rb, ok := backend.(RunnerBackend)
+		if !ok {
+			return Faucet{}, ErrLedgerRequired
+		}
+
+		f.ledger = NewTxEventsLedger(rb.runner, f.accountName)
+	}
+
+	if f.multiSender != nil {
+		if f.batchMaxSize == 0 {
+			f.batchMaxSize = DefaultBatchMaxSize
+		}
+
+		f.batcher = newBatcher(f.multiSender, f.ledger, f.accountName, f.batchWindow, f.batchMaxSize)
+	}
+
+	if f.challengeDifficulty > 0 {
+		if f.challengeTTL == 0 {
+			f.challengeTTL = DefaultChallengeTTL
+		}
+
+		c, err := newChallenger(f.challengeDifficulty, f.challengeTTL)
+		if err != nil {
+			return Faucet{}, err
+		}
+
+		f.challenger = c
+	}
+
 	// import the account if mnemonic is provided.
 	if f.accountMnemonic != "" {
-		_, err := f.runner.AddAccount(ctx, f.accountName, f.accountMnemonic, f.coinType)
-		if err != nil && err != chaincmdrunner.ErrAccountAlreadyExists {
+		if err := f.backend.ImportAccount(ctx, f.accountName, f.accountMnemonic, f.coinType); err != nil {
 			return Faucet{}, err
 		}
 	}
 
 	if f.chainID == "" {
-		status, err := f.runner.Status(ctx)
+		chainID, err := f.backend.ChainID(ctx)
 		if err != nil {
 			return Faucet{}, err
 		}
 
-		f.chainID = status.ChainID
-		f.openAPIData.ChainID = status.ChainID
+		f.chainID = chainID
+		f.openAPIData.ChainID = chainID
 	}
 
 	return f, nil
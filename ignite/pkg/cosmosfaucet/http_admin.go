@@ -0,0 +1,116 @@
+package cosmosfaucet
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/xhttp"
+)
+
+const (
+	// defaultHistoryLimit is the default number of transfers returned by the history endpoint.
+	defaultHistoryLimit = 100
+
+	// metricsContentType is the content type of the Prometheus text exposition format.
+	metricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+var (
+	// ErrUnauthorized is returned when an admin request doesn't have a valid token.
+	ErrUnauthorized = errors.New("invalid or missing admin token")
+
+	// ErrHistoryNotSupported is returned when the faucet ledger can't list the transfers.
+	ErrHistoryNotSupported = errors.New("faucet ledger doesn't support transfer history")
+)
+
+// HistoryRecord is a transfer listed by the history endpoint.
+type HistoryRecord struct {
+	// Address is the account address that received the coins.
+	Address string `json:"address"`
+
+	// Coins is the list of transferred coins.
+	Coins []string `json:"coins"`
+
+	// Time is the time when the transfer happened.
+	Time time.Time `json:"time"`
+}
+
+// HistoryResponse is the transfer history payload.
+type HistoryResponse struct {
+	Transfers []HistoryRecord `json:"transfers"`
+	Error     string          `json:"error,omitempty"`
+}
+
+func (f Faucet) metricsHandler(w http.ResponseWriter, r *http.Request) {
+	// refresh the balance, the last known one is used when the chain can't be reached.
+	_ = f.updateBalance(r.Context())
+
+	w.Header().Set("Content-Type", metricsContentType)
+	w.WriteHeader(http.StatusOK)
+	_ = f.metrics.write(w)
+}
+
+func (f Faucet) historyHandler(w http.ResponseWriter, r *http.Request) {
+	if f.adminToken == "" {
+		responseHistoryError(w, http.StatusNotFound, errors.New("faucet admin endpoints are disabled"))
+		return
+	}
+
+	if !f.isAdmin(r) {
+		responseHistoryError(w, http.StatusUnauthorized, ErrUnauthorized)
+		return
+	}
+
+	ledger, ok := f.ledger.(HistoryLedger)
+	if !ok {
+		responseHistoryError(w, http.StatusNotImplemented, ErrHistoryNotSupported)
+		return
+	}
+
+	limit := defaultHistoryLimit
+	if l := r.URL.Query().Get("limit"); l != "" {
+		var err error
+		if limit, err = strconv.Atoi(l); err != nil || limit <= 0 {
+			responseHistoryError(w, http.StatusBadRequest, errors.New("limit must be a positive number"))
+			return
+		}
+	}
+
+	records, err := ledger.History(r.Context(), limit)
+	if err != nil {
+		responseHistoryError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	res := HistoryResponse{Transfers: make([]HistoryRecord, len(records))}
+	for i, rec := range records {
+		coins := make([]string, len(rec.Coins))
+		for j, c := range rec.Coins {
+			coins[j] = c.String()
+		}
+
+		res.Transfers[i] = HistoryRecord{
+			Address: rec.Address,
+			Coins:   coins,
+			Time:    rec.Time,
+		}
+	}
+
+	xhttp.ResponseJSON(w, http.StatusOK, res)
+}
+
+// isAdmin checks if the request is authorized with the admin bearer token.
+func (f Faucet) isAdmin(r *http.Request) bool {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(f.adminToken)) == 1
+}
+
+func responseHistoryError(w http.ResponseWriter, code int, err error) {
+	xhttp.ResponseJSON(w, code, HistoryResponse{
+		Error: err.Error(),
+	})
+}
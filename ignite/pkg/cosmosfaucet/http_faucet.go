@@ -3,7 +3,10 @@ package cosmosfaucet
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net"
 	"net/http"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -17,6 +20,13 @@ type TransferRequest struct {
 	// Coins that are requested.
 	// default ones used when this one isn't provided.
 	Coins []string `json:"coins"`
+
+	// Challenge is a proof-of-work challenge issued by the faucet.
+	// it is required only when the faucet has challenges enabled.
+	Challenge string `json:"challenge,omitempty"`
+
+	// Nonce is the solution of the challenge for the account address.
+	Nonce string `json:"nonce,omitempty"`
 }
 
 func NewTransferRequest(accountAddress string, coins []string) TransferRequest {
@@ -27,34 +37,77 @@ func NewTransferRequest(accountAddress string, coins []string) TransferRequest {
 }
 
 type TransferResponse struct {
+	// TxHash is the hash of the transaction that sent the coins.
+	TxHash string `json:"tx_hash,omitempty"`
+
 	Error string `json:"error,omitempty"`
 }
 
+var (
+	// ErrIPRateLimited is returned when the client IP address exceeded its requests limit.
+	ErrIPRateLimited = errors.New("too many requests from this IP address, try again later")
+
+	// ErrGlobalRateLimited is returned when the faucet exceeded its requests limit.
+	ErrGlobalRateLimited = errors.New("faucet is receiving too many requests, try again later")
+)
+
 func (f Faucet) faucetHandler(w http.ResponseWriter, r *http.Request) {
+	coins, txHash, code, err := f.handleTransfer(r)
+	f.metrics.addRequest(coins, err)
+
+	if err != nil {
+		if err == context.Canceled {
+			return
+		}
+		responseError(w, code, err)
+		return
+	}
+
+	responseSuccess(w, txHash)
+
+	// refresh the faucet balance without delaying the response.
+	go f.updateBalance(context.Background())
+}
+
+// handleTransfer performs the transfer request and returns the transferred coins,
+// the tx hash or an error with the HTTP status code to respond with.
+func (f Faucet) handleTransfer(r *http.Request) (coins sdk.Coins, txHash string, code int, err error) {
 	var req TransferRequest
 
+	// check the request limits before doing any work.
+	now := time.Now()
+	if !f.ipLimiter.Allow(clientIP(r), now) {
+		return nil, "", http.StatusTooManyRequests, ErrIPRateLimited
+	}
+	if !f.globalLimiter.Allow("", now) {
+		return nil, "", http.StatusTooManyRequests, ErrGlobalRateLimited
+	}
+
 	// decode request into req.
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		responseError(w, http.StatusBadRequest, err)
-		return
+		return nil, "", http.StatusBadRequest, err
+	}
+
+	// verify the proof-of-work when challenges are enabled.
+	if f.challenger != nil {
+		if err := f.challenger.Verify(req.Challenge, req.AccountAddress, req.Nonce, now); err != nil {
+			return nil, "", http.StatusForbidden, err
+		}
 	}
 
 	// determine coins to transfer.
-	coins, err := f.coinsFromRequest(req)
+	coins, err = f.coinsFromRequest(req)
 	if err != nil {
-		responseError(w, http.StatusBadRequest, err)
-		return
+		return nil, "", http.StatusBadRequest, err
 	}
 
 	// try performing the transfer
-	if err := f.Transfer(r.Context(), req.AccountAddress, coins); err != nil {
-		if err == context.Canceled {
-			return
-		}
-		responseError(w, http.StatusInternalServerError, err)
-	} else {
-		responseSuccess(w)
+	txHash, err = f.Transfer(r.Context(), req.AccountAddress, coins)
+	if err != nil {
+		return nil, "", http.StatusInternalServerError, err
 	}
+
+	return coins, txHash, http.StatusOK, nil
 }
 
 // FaucetInfoResponse is the faucet info payload.
@@ -65,13 +118,67 @@ type FaucetInfoResponse struct {
 
 	// ChainID is chain id of the chain that faucet is running for.
 	ChainID string `json:"chain_id"`
+
+	// IPRateLimit is the requests limit applied to each client IP address.
+	IPRateLimit *RateLimitInfo `json:"ip_rate_limit,omitempty"`
+
+	// GlobalRateLimit is the requests limit applied to all the clients.
+	GlobalRateLimit *RateLimitInfo `json:"global_rate_limit,omitempty"`
+
+	// ChallengeDifficulty is the number of leading zero bits required to solve
+	// a challenge. challenges are not required when it's zero.
+	ChallengeDifficulty uint `json:"challenge_difficulty,omitempty"`
+}
+
+// RateLimitInfo describes a requests limit.
+type RateLimitInfo struct {
+	// Requests is the max. number of requests allowed within the period.
+	Requests int `json:"requests"`
+
+	// Period is the time window of the limit.
+	Period string `json:"period"`
 }
 
 func (f Faucet) faucetInfoHandler(w http.ResponseWriter, r *http.Request) {
-	xhttp.ResponseJSON(w, http.StatusOK, FaucetInfoResponse{
-		IsAFaucet: true,
-		ChainID:   f.chainID,
-	})
+	info := FaucetInfoResponse{
+		IsAFaucet:       true,
+		ChainID:         f.chainID,
+		IPRateLimit:     f.ipLimiter.info(),
+		GlobalRateLimit: f.globalLimiter.info(),
+	}
+
+	if f.challenger != nil {
+		info.ChallengeDifficulty = f.challenger.difficulty
+	}
+
+	xhttp.ResponseJSON(w, http.StatusOK, info)
+}
+
+// ChallengeResponse is the payload of a proof-of-work challenge.
+type ChallengeResponse struct {
+	// Challenge to solve.
+	Challenge string `json:"challenge"`
+
+	// Difficulty is the number of leading zero bits required in the solution hash.
+	Difficulty uint `json:"difficulty"`
+
+	// ExpiresAt is the time after which the challenge is not accepted anymore.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (f Faucet) faucetChallengeHandler(w http.ResponseWriter, r *http.Request) {
+	if f.challenger == nil {
+		responseError(w, http.StatusNotFound, errors.New("faucet doesn't require challenges"))
+		return
+	}
+
+	challenge, err := f.challenger.New(time.Now())
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	xhttp.ResponseJSON(w, http.StatusOK, challenge)
 }
 
 // coinsFromRequest determines tokens to transfer from transfer request.
@@ -92,8 +199,20 @@ func (f Faucet) coinsFromRequest(req TransferRequest) (sdk.Coins, error) {
 	return coins, nil
 }
 
-func responseSuccess(w http.ResponseWriter) {
-	xhttp.ResponseJSON(w, http.StatusOK, TransferResponse{})
+// clientIP returns the IP address of the client that made the request.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+func responseSuccess(w http.ResponseWriter, txHash string) {
+	xhttp.ResponseJSON(w, http.StatusOK, TransferResponse{
+		TxHash: txHash,
+	})
 }
 
 func responseError(w http.ResponseWriter, code int, err error) {
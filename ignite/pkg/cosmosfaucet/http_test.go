@@ -1,12 +1,17 @@
 package cosmosfaucet_test
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
 )
 
@@ -25,6 +30,11 @@ func TestServeHTTPCORS(t *testing.T) {
 			method: "GET",
 			path:   "/info",
 		},
+		{
+			name:   "challenge endpoint",
+			method: "GET",
+			path:   "/challenge",
+		},
 	}
 
 	for _, tt := range cases {
@@ -42,3 +52,40 @@ func TestServeHTTPCORS(t *testing.T) {
 		})
 	}
 }
+
+func TestServeHTTPLimits(t *testing.T) {
+	f, err := cosmosfaucet.New(
+		context.Background(),
+		chaincmdrunner.Runner{},
+		cosmosfaucet.ChainID("test-1"),
+		cosmosfaucet.IPRateLimit(1, time.Hour),
+		cosmosfaucet.Challenge(8, 0),
+	)
+	require.NoError(t, err)
+
+	transfer := func(remoteAddr string) *httptest.ResponseRecorder {
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/", strings.NewReader(`{"address":"cosmos1p8d9e0dc2xcrk6f6qrmvxxz6vq6f4t7l0p5ls0"}`))
+		req.RemoteAddr = remoteAddr
+		f.ServeHTTP(res, req)
+		return res
+	}
+
+	// Assert: the challenge is required
+	require.Equal(t, http.StatusForbidden, transfer("10.0.0.1:1000").Result().StatusCode)
+
+	// Assert: a second request from the same IP is rate limited
+	require.Equal(t, http.StatusTooManyRequests, transfer("10.0.0.1:2000").Result().StatusCode)
+	require.Equal(t, http.StatusForbidden, transfer("10.0.0.2:1000").Result().StatusCode)
+
+	// Assert: limits are reported by the info endpoint
+	res := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/info", nil)
+	f.ServeHTTP(res, req)
+
+	var info cosmosfaucet.FaucetInfoResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&info))
+	require.EqualValues(t, 8, info.ChallengeDifficulty)
+	require.Equal(t, &cosmosfaucet.RateLimitInfo{Requests: 1, Period: "1h0m0s"}, info.IPRateLimit)
+	require.Nil(t, info.GlobalRateLimit)
+}
@@ -0,0 +1,49 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// LedgerBackendEmbedded is the name of the ledger backend that keeps transfers
+	// in an embedded key-value store.
+	LedgerBackendEmbedded = "embedded"
+
+	// LedgerBackendTxEvents is the name of the ledger backend that computes transfers
+	// by querying the tx events of the chain.
+	LedgerBackendTxEvents = "events"
+)
+
+// TransferRecord is a transfer of coins made by the faucet to an account.
+type TransferRecord struct {
+	// Address is the account address that received the coins.
+	Address string
+
+	// Coins is the list of transferred coins.
+	Coins sdk.Coins
+
+	// Time is the time when the transfer happened.
+	Time time.Time
+}
+
+// Ledger keeps track of the coins transferred by the faucet to the accounts
+// so the max. amount limits can be enforced.
+type Ledger interface {
+	// Record records a transfer of coins made to an account.
+	Record(ctx context.Context, record TransferRecord) error
+
+	// TotalTransferred returns the total amount of denom transferred to address
+	// after the since time.
+	TotalTransferred(ctx context.Context, address, denom string, since time.Time) (uint64, error)
+}
+
+// HistoryLedger is a ledger that can list the recorded transfers.
+type HistoryLedger interface {
+	Ledger
+
+	// History returns up to limit of the most recent transfers, newest first.
+	History(ctx context.Context, limit int) ([]TransferRecord, error)
+}
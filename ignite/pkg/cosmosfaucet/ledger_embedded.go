@@ -0,0 +1,158 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+)
+
+const (
+	// ledgerNamespace is the cache namespace prefix used to store the faucet transfers.
+	ledgerNamespace = "cosmosfaucet.ledger."
+
+	// historyKey is the key of the most recent transfers.
+	historyKey = "history"
+
+	// historySize is the max. number of the most recent transfers kept in the history.
+	historySize = 1000
+)
+
+// ledgerEntry is the stored representation of a transfer.
+// Coins are stored as a string because sdk.Int cannot be gob encoded.
+type ledgerEntry struct {
+	Address string
+	Coins   string
+	Time    time.Time
+}
+
+// EmbeddedLedger is a ledger that stores the transfers in an embedded
+// key-value store so they survive faucet restarts and chain state resets.
+type EmbeddedLedger struct {
+	entries cache.Cache[[]ledgerEntry]
+	history cache.Cache[[]ledgerEntry]
+}
+
+// NewEmbeddedLedger creates a new ledger that keeps the transfers made for
+// the chain with chainID inside storage.
+func NewEmbeddedLedger(storage cache.Storage, chainID string) EmbeddedLedger {
+	return EmbeddedLedger{
+		entries: cache.New[[]ledgerEntry](storage, ledgerNamespace+chainID),
+		history: cache.New[[]ledgerEntry](storage, ledgerNamespace+chainID+".history"),
+	}
+}
+
+// Record implements Ledger.
+func (l EmbeddedLedger) Record(_ context.Context, record TransferRecord) error {
+	entries, err := l.get(record.Address)
+	if err != nil {
+		return err
+	}
+
+	entry := ledgerEntry{
+		Address: record.Address,
+		Coins:   record.Coins.String(),
+		Time:    record.Time,
+	}
+
+	if err := l.entries.Put(record.Address, append(entries, entry)); err != nil {
+		return err
+	}
+
+	history, err := l.getHistory()
+	if err != nil {
+		return err
+	}
+
+	history = append(history, entry)
+	if len(history) > historySize {
+		history = history[len(history)-historySize:]
+	}
+
+	return l.history.Put(historyKey, history)
+}
+
+// TotalTransferred implements Ledger.
+func (l EmbeddedLedger) TotalTransferred(_ context.Context, address, denom string, since time.Time) (totalAmount uint64, err error) {
+	records, err := l.Transfers(address)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, r := range records {
+		if r.Time.After(since) {
+			totalAmount += r.Coins.AmountOf(denom).Uint64()
+		}
+	}
+
+	return totalAmount, nil
+}
+
+// Transfers returns all the transfers recorded for an address.
+func (l EmbeddedLedger) Transfers(address string) ([]TransferRecord, error) {
+	entries, err := l.get(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRecords(entries)
+}
+
+// History implements HistoryLedger.
+func (l EmbeddedLedger) History(_ context.Context, limit int) ([]TransferRecord, error) {
+	history, err := l.getHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	if limit > 0 && len(history) > limit {
+		history = history[len(history)-limit:]
+	}
+
+	// sort from the newest to the oldest transfer
+	entries := make([]ledgerEntry, len(history))
+	for i, e := range history {
+		entries[len(history)-1-i] = e
+	}
+
+	return toRecords(entries)
+}
+
+func (l EmbeddedLedger) get(address string) ([]ledgerEntry, error) {
+	entries, err := l.entries.Get(address)
+	if errors.Is(err, cache.ErrorNotFound) {
+		return nil, nil
+	}
+
+	return entries, err
+}
+
+func (l EmbeddedLedger) getHistory() ([]ledgerEntry, error) {
+	history, err := l.history.Get(historyKey)
+	if errors.Is(err, cache.ErrorNotFound) {
+		return nil, nil
+	}
+
+	return history, err
+}
+
+func toRecords(entries []ledgerEntry) ([]TransferRecord, error) {
+	records := make([]TransferRecord, len(entries))
+	for i, e := range entries {
+		coins, err := sdk.ParseCoinsNormalized(e.Coins)
+		if err != nil {
+			return nil, err
+		}
+
+		records[i] = TransferRecord{
+			Address: e.Address,
+			Coins:   coins,
+			Time:    e.Time,
+		}
+	}
+
+	return records, nil
+}
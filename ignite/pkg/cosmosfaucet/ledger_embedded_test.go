@@ -0,0 +1,75 @@
+package cosmosfaucet_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+)
+
+func TestEmbeddedLedger(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		address = "cosmos1p8d9e0dc2xcrk6f6qrmvxxz6vq6f4t7l0p5ls0"
+		now     = time.Now()
+	)
+
+	storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "faucet.db"))
+	require.NoError(t, err)
+
+	ledger := cosmosfaucet.NewEmbeddedLedger(storage, "test-1")
+
+	// Arrange: an old transfer and a recent one
+	err = ledger.Record(ctx, cosmosfaucet.TransferRecord{
+		Address: address,
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin("token", 10)),
+		Time:    now.Add(-time.Hour * 2),
+	})
+	require.NoError(t, err)
+
+	err = ledger.Record(ctx, cosmosfaucet.TransferRecord{
+		Address: address,
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin("token", 5), sdk.NewInt64Coin("stake", 1)),
+		Time:    now,
+	})
+	require.NoError(t, err)
+
+	// Assert: only transfers within the window are accounted
+	total, err := ledger.TotalTransferred(ctx, address, "token", now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.EqualValues(t, 5, total)
+
+	total, err = ledger.TotalTransferred(ctx, address, "token", now.Add(-time.Hour*3))
+	require.NoError(t, err)
+	require.EqualValues(t, 15, total)
+
+	// Assert: transfers are persisted and kept per chain
+	total, err = cosmosfaucet.NewEmbeddedLedger(storage, "test-1").TotalTransferred(ctx, address, "stake", time.Time{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, total)
+
+	total, err = cosmosfaucet.NewEmbeddedLedger(storage, "test-2").TotalTransferred(ctx, address, "token", time.Time{})
+	require.NoError(t, err)
+	require.Zero(t, total)
+
+	records, err := ledger.Transfers(address)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, address, records[1].Address)
+
+	// Assert: the history lists the most recent transfers first
+	history, err := ledger.History(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, "1stake,5token", history[0].Coins.String())
+
+	history, err = ledger.History(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+}
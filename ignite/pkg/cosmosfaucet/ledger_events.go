@@ -0,0 +1,69 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+)
+
+// TxEventsLedger is a ledger that computes the transferred amounts by querying
+// the tx events of the chain where the faucet account is the sender.
+// It doesn't store anything so transfers are lost when the chain state is reset
+// or when the node prunes its tx index.
+type TxEventsLedger struct {
+	runner      chaincmdrunner.Runner
+	accountName string
+}
+
+// NewTxEventsLedger creates a new ledger that uses the tx events of the chain
+// accessed with ccr to find the transfers made from the accountName account.
+func NewTxEventsLedger(ccr chaincmdrunner.Runner, accountName string) TxEventsLedger {
+	return TxEventsLedger{
+		runner:      ccr,
+		accountName: accountName,
+	}
+}
+
+// Record implements Ledger. Transfers are already recorded by the chain so it does nothing.
+func (l TxEventsLedger) Record(context.Context, TransferRecord) error {
+	return nil
+}
+
+// TotalTransferred implements Ledger.
+func (l TxEventsLedger) TotalTransferred(ctx context.Context, address, denom string, since time.Time) (totalAmount uint64, err error) {
+	fromAccount, err := l.runner.ShowAccount(ctx, l.accountName)
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := l.runner.QueryTxEvents(ctx,
+		chaincmdrunner.NewEventSelector("message", "sender", fromAccount.Address),
+		chaincmdrunner.NewEventSelector("transfer", "recipient", address))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		if event.Type == "transfer" {
+			for _, attr := range event.Attributes {
+				if attr.Key == "amount" {
+					coins, err := sdk.ParseCoinsNormalized(attr.Value)
+					if err != nil {
+						return 0, err
+					}
+
+					amount := coins.AmountOf(denom).Uint64()
+
+					if amount > 0 && event.Time.After(since) {
+						totalAmount += amount
+					}
+				}
+			}
+		}
+	}
+
+	return totalAmount, nil
+}
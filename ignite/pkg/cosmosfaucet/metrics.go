@@ -0,0 +1,165 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+// metrics keeps the faucet counters and its last known balance.
+type metrics struct {
+	mu sync.Mutex
+
+	// requests is the number of transfer requests received.
+	requests uint64
+
+	// failures is the number of transfer requests that failed.
+	failures uint64
+
+	// transferred holds the transferred amounts by denom.
+	transferred map[string]sdkmath.Int
+
+	// balance is the last known balance of the faucet account.
+	balance sdk.Coins
+
+	// lowBalance holds the denoms that are below the low balance threshold.
+	// it's used to send an event only when the balance goes below the threshold.
+	lowBalance map[string]bool
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		transferred: make(map[string]sdkmath.Int),
+		lowBalance:  make(map[string]bool),
+	}
+}
+
+func (m *metrics) addRequest(coins sdk.Coins, err error) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests++
+
+	if err != nil {
+		m.failures++
+		return
+	}
+
+	for _, c := range coins {
+		amount, ok := m.transferred[c.Denom]
+		if !ok {
+			amount = sdkmath.ZeroInt()
+		}
+
+		m.transferred[c.Denom] = amount.Add(c.Amount)
+	}
+}
+
+// setBalance updates the faucet balance and returns the coins of the denoms
+// that went below the threshold since the previous update, including the
+// denoms with no balance left.
+func (m *metrics) setBalance(balance, threshold sdk.Coins) (low []sdk.Coin) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.balance = balance
+
+	for _, t := range threshold {
+		amount := balance.AmountOf(t.Denom)
+		isLow := amount.LT(t.Amount)
+
+		if isLow && !m.lowBalance[t.Denom] {
+			// append instead of adding the coin so denoms with a zero amount are kept
+			low = append(low, sdk.NewCoin(t.Denom, amount))
+		}
+
+		m.lowBalance[t.Denom] = isLow
+	}
+
+	return low
+}
+
+// write writes the metrics using the Prometheus text exposition format.
+func (m *metrics) write(w io.Writer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	denoms := make([]string, 0, len(m.transferred))
+	for denom := range m.transferred {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	_, err := fmt.Fprintf(w, `# HELP faucet_requests_total Number of transfer requests received.
+# TYPE faucet_requests_total counter
+faucet_requests_total %d
+# HELP faucet_request_failures_total Number of transfer requests that failed.
+# TYPE faucet_request_failures_total counter
+faucet_request_failures_total %d
+# HELP faucet_transferred_amount_total Amount of coins transferred by denom.
+# TYPE faucet_transferred_amount_total counter
+`, m.requests, m.failures)
+	if err != nil {
+		return err
+	}
+
+	for _, denom := range denoms {
+		if _, err := fmt.Fprintf(w, "faucet_transferred_amount_total{denom=%q} %s\n", denom, m.transferred[denom]); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprint(w, `# HELP faucet_balance Balance of the faucet account by denom.
+# TYPE faucet_balance gauge
+`)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range m.balance {
+		if _, err := fmt.Fprintf(w, "faucet_balance{denom=%q} %s\n", c.Denom, c.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateBalance queries the balance of the faucet account and sends an event
+// when the amount of a denom goes below the low balance threshold.
+func (f Faucet) updateBalance(ctx context.Context) error {
+	if f.metrics == nil {
+		return nil
+	}
+
+	address, err := f.backend.AccountAddress(ctx, f.accountName)
+	if err != nil {
+		return err
+	}
+
+	balance, err := f.backend.Balances(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	if low := f.metrics.setBalance(balance, f.lowBalanceThreshold); len(low) > 0 {
+		f.ev.Send(
+			fmt.Sprintf("Faucet balance is running low: %s", sdk.Coins(low)),
+			events.Icon(icons.Info),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package cosmosfaucet
+
+import (
+	"sync"
+	"time"
+)
+
+// maxIdleBuckets is the number of per key buckets kept before the ones
+// that are fully refilled are removed from memory.
+const maxIdleBuckets = 10000
+
+// tokenBucket is a token bucket that is refilled at a constant rate
+// until it reaches its capacity.
+type tokenBucket struct {
+	tokens   float64
+	lastFill time.Time
+}
+
+// rateLimiter limits the number of requests using a token bucket per key.
+// An empty key can be used to apply a single global limit.
+type rateLimiter struct {
+	// requests is the capacity of the buckets.
+	requests int
+
+	// period is the time that takes to completely refill a bucket.
+	period time.Duration
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+func newRateLimiter(requests int, period time.Duration) *rateLimiter {
+	return &rateLimiter{
+		requests: requests,
+		period:   period,
+		buckets:  make(map[string]*tokenBucket),
+	}
+}
+
+// Allow consumes a token from the bucket of key and returns false
+// when the bucket is empty and the request must be rejected.
+func (l *rateLimiter) Allow(key string, now time.Time) bool {
+	if l == nil {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxIdleBuckets {
+			l.removeFullBuckets(now)
+		}
+
+		b = &tokenBucket{
+			tokens:   float64(l.requests),
+			lastFill: now,
+		}
+		l.buckets[key] = b
+	}
+
+	l.refill(b, now)
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+
+	return true
+}
+
+func (l *rateLimiter) refill(b *tokenBucket, now time.Time) {
+	elapsed := now.Sub(b.lastFill)
+	if elapsed <= 0 {
+		return
+	}
+
+	b.tokens += float64(l.requests) * elapsed.Seconds() / l.period.Seconds()
+	if b.tokens > float64(l.requests) {
+		b.tokens = float64(l.requests)
+	}
+
+	b.lastFill = now
+}
+
+func (l *rateLimiter) removeFullBuckets(now time.Time) {
+	for key, b := range l.buckets {
+		l.refill(b, now)
+
+		if b.tokens >= float64(l.requests) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// info returns the rate limit info to report to the clients.
+func (l *rateLimiter) info() *RateLimitInfo {
+	if l == nil {
+		return nil
+	}
+
+	return &RateLimitInfo{
+		Requests: l.requests,
+		Period:   l.period.String(),
+	}
+}
@@ -3,99 +3,84 @@ package cosmosfaucet
 import (
 	"context"
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-
-	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
 )
 
 // transferMutex is a mutex used for keeping transfer requests in a queue so checking account balance and sending tokens is atomic
 var transferMutex = &sync.Mutex{}
 
-// TotalTransferredAmount returns the total transferred amount from faucet account to toAccountAddress.
+// TotalTransferredAmount returns the total transferred amount from faucet account to toAccountAddress
+// within the limit refresh window.
 func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, denom string) (totalAmount uint64, err error) {
-	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
-	if err != nil {
-		return 0, err
-	}
+	return f.ledger.TotalTransferred(ctx, toAccountAddress, denom, time.Now().Add(-f.limitRefreshWindow))
+}
 
-	events, err := f.runner.QueryTxEvents(ctx,
-		chaincmdrunner.NewEventSelector("message", "sender", fromAccount.Address),
-		chaincmdrunner.NewEventSelector("transfer", "recipient", toAccountAddress))
-	if err != nil {
-		return 0, err
+// Transfer transfer amount of tokens from the faucet account to toAccountAddress
+// and returns the hash of the transaction. When batching is enabled the transfer
+// is sent together with other transfers in a single transaction.
+func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) (txHash string, err error) {
+	if f.batcher != nil {
+		return f.batcher.transfer(ctx, *f, toAccountAddress, coins)
 	}
 
-	for _, event := range events {
-		if event.Type == "transfer" {
-			for _, attr := range event.Attributes {
-				if attr.Key == "amount" {
-					coins, err := sdk.ParseCoinsNormalized(attr.Value)
-					if err != nil {
-						return 0, err
-					}
-
-					amount := coins.AmountOf(denom).Uint64()
-
-					if amount > 0 && time.Since(event.Time) < f.limitRefreshWindow {
-						totalAmount += amount
-					}
-				}
-			}
-		}
+	transferMutex.Lock()
+	defer transferMutex.Unlock()
+
+	if err := f.checkLimits(ctx, toAccountAddress, coins, nil); err != nil {
+		return "", err
 	}
 
-	return totalAmount, nil
-}
+	// perform transfer for all coins
+	txHash, err = f.backend.Send(ctx, f.accountName, toAccountAddress, coins)
+	if err != nil {
+		return "", err
+	}
 
-// Transfer transfer amount of tokens from the faucet account to toAccountAddress.
-func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) error {
-	transferMutex.Lock()
-	defer transferMutex.Unlock()
+	// record the transfer to keep track of the limits
+	err = f.ledger.Record(ctx, TransferRecord{
+		Address: toAccountAddress,
+		Coins:   coins,
+		Time:    time.Now(),
+	})
 
-	var coinsStr []string
+	return txHash, err
+}
 
+// checkLimits checks that transferring coins to toAccountAddress doesn't exceed the max. amounts.
+// pending are the coins that are being transferred to the account but are not recorded yet.
+func (f Faucet) checkLimits(ctx context.Context, toAccountAddress string, coins, pending sdk.Coins) error {
 	// check for each coin, the max transferred amount hasn't been reached
 	for _, c := range coins {
+		if f.coinsMax[c.Denom] == 0 {
+			continue
+		}
+
 		totalSent, err := f.TotalTransferredAmount(ctx, toAccountAddress, c.Denom)
 		if err != nil {
 			return err
 		}
 
-		if f.coinsMax[c.Denom] != 0 {
-			if totalSent >= f.coinsMax[c.Denom] {
-				return fmt.Errorf(
-					"account has reached to the max. allowed amount (%d) for %q denom",
-					f.coinsMax[c.Denom],
-					c.Denom,
-				)
-			}
-
-			if (totalSent + c.Amount.Uint64()) > f.coinsMax[c.Denom] {
-				return fmt.Errorf(
-					`ask less amount for %q denom. account is reaching to the limit (%d) that faucet can tolerate`,
-					c.Denom,
-					f.coinsMax[c.Denom],
-				)
-			}
-		}
+		totalSent += pending.AmountOf(c.Denom).Uint64()
 
-		coinsStr = append(coinsStr, c.String())
-	}
+		if totalSent >= f.coinsMax[c.Denom] {
+			return fmt.Errorf(
+				"account has reached to the max. allowed amount (%d) for %q denom",
+				f.coinsMax[c.Denom],
+				c.Denom,
+			)
+		}
 
-	// perform transfer for all coins
-	fromAccount, err := f.runner.ShowAccount(ctx, f.accountName)
-	if err != nil {
-		return err
-	}
-	txHash, err := f.runner.BankSend(ctx, fromAccount.Address, toAccountAddress, strings.Join(coinsStr, ","))
-	if err != nil {
-		return err
+		if (totalSent + c.Amount.Uint64()) > f.coinsMax[c.Denom] {
+			return fmt.Errorf(
+				`ask less amount for %q denom. account is reaching to the limit (%d) that faucet can tolerate`,
+				c.Denom,
+				f.coinsMax[c.Denom],
+			)
+		}
 	}
 
-	// wait for the send tx to be confirmed
-	return f.runner.WaitTx(ctx, txHash, time.Second, 30)
+	return nil
 }
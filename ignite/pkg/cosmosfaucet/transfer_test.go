@@ -0,0 +1,180 @@
+package cosmosfaucet_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+type backend struct {
+	sent []sdk.Coins
+
+	// balance is the faucet balance, the default balance is used when nil.
+	balance sdk.Coins
+}
+
+func (b *backend) ChainID(context.Context) (string, error) {
+	return "test-1", nil
+}
+
+func (b *backend) ImportAccount(context.Context, string, string, string) error {
+	return nil
+}
+
+func (b *backend) AccountAddress(context.Context, string) (string, error) {
+	return "cosmos1faucet", nil
+}
+
+func (b *backend) Send(_ context.Context, _, _ string, coins sdk.Coins) (string, error) {
+	b.sent = append(b.sent, coins)
+	return "HASH", nil
+}
+
+func (b *backend) Balances(context.Context, string) (sdk.Coins, error) {
+	if b.balance != nil {
+		return b.balance, nil
+	}
+	return sdk.NewCoins(sdk.NewInt64Coin("token", 100)), nil
+}
+
+func TestTransferWithBackend(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		b     = &backend{}
+		coins = sdk.NewCoins(sdk.NewInt64Coin("token", 10))
+	)
+
+	// Assert: a ledger is required for backends other than the runner
+	_, err := cosmosfaucet.NewWithBackend(ctx, b)
+	require.ErrorIs(t, err, cosmosfaucet.ErrLedgerRequired)
+
+	storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "faucet.db"))
+	require.NoError(t, err)
+
+	f, err := cosmosfaucet.NewWithBackend(
+		ctx,
+		b,
+		cosmosfaucet.Coin(10, 20, "token"),
+		cosmosfaucet.WithLedger(cosmosfaucet.NewEmbeddedLedger(storage, "test-1")),
+	)
+	require.NoError(t, err)
+
+	// Act
+	for i := 0; i < 2; i++ {
+		txHash, err := f.Transfer(ctx, "cosmos1a", coins)
+		require.NoError(t, err)
+		require.Equal(t, "HASH", txHash)
+	}
+
+	_, err = f.Transfer(ctx, "cosmos1a", coins)
+
+	// Assert
+	require.Error(t, err)
+	require.Equal(t, []sdk.Coins{coins, coins}, b.sent)
+}
+
+func TestLowBalanceEvent(t *testing.T) {
+	ctx := context.Background()
+
+	storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "faucet.db"))
+	require.NoError(t, err)
+
+	// Arrange: a faucet fully drained of "token" and low on "stake"
+	var (
+		b  = &backend{balance: sdk.NewCoins(sdk.NewInt64Coin("stake", 5))}
+		ev = events.NewBus()
+	)
+	f, err := cosmosfaucet.NewWithBackend(
+		ctx,
+		b,
+		cosmosfaucet.WithLedger(cosmosfaucet.NewEmbeddedLedger(storage, "test-1")),
+		cosmosfaucet.LowBalance(sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("token", 10))),
+		cosmosfaucet.CollectEvents(ev),
+	)
+	require.NoError(t, err)
+
+	updateBalance := func() {
+		req, _ := http.NewRequest("GET", "/metrics", nil)
+		f.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	// Act
+	updateBalance()
+	updateBalance()
+
+	// Assert: the event is sent once while the balance stays low
+	require.Len(t, ev.Events(), 1)
+	require.Equal(t, "Faucet balance is running low: 5stake,0token", (<-ev.Events()).Message)
+
+	// Act: the balance is refilled and drained again
+	b.balance = sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("token", 100))
+	updateBalance()
+	b.balance = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	updateBalance()
+
+	// Assert
+	require.Len(t, ev.Events(), 1)
+	require.Equal(t, "Faucet balance is running low: 0token", (<-ev.Events()).Message)
+}
+
+func TestServeHTTPMetricsAndHistory(t *testing.T) {
+	ctx := context.Background()
+
+	storage, err := cache.NewStorage(filepath.Join(t.TempDir(), "faucet.db"))
+	require.NoError(t, err)
+
+	f, err := cosmosfaucet.NewWithBackend(
+		ctx,
+		&backend{},
+		cosmosfaucet.Coin(10, 0, "token"),
+		cosmosfaucet.WithLedger(cosmosfaucet.NewEmbeddedLedger(storage, "test-1")),
+		cosmosfaucet.AdminToken("secret"),
+	)
+	require.NoError(t, err)
+
+	serve := func(method, path, body, token string) *httptest.ResponseRecorder {
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		f.ServeHTTP(res, req)
+		return res
+	}
+
+	// Arrange: a successful and a failed transfer
+	require.Equal(t, http.StatusOK, serve("POST", "/", `{"address":"cosmos1a"}`, "").Code)
+	require.Equal(t, http.StatusBadRequest, serve("POST", "/", `{"address":"cosmos1a","coins":["invalid"]}`, "").Code)
+
+	// Assert: metrics are exposed in the Prometheus text format
+	res := serve("GET", "/metrics", "", "")
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Contains(t, res.Body.String(), "faucet_requests_total 2\n")
+	require.Contains(t, res.Body.String(), "faucet_request_failures_total 1\n")
+	require.Contains(t, res.Body.String(), `faucet_transferred_amount_total{denom="token"} 10`)
+	require.Contains(t, res.Body.String(), `faucet_balance{denom="token"} 100`)
+
+	// Assert: the history requires the admin token
+	require.Equal(t, http.StatusUnauthorized, serve("GET", "/admin/history", "", "").Code)
+	require.Equal(t, http.StatusUnauthorized, serve("GET", "/admin/history", "", "wrong").Code)
+
+	res = serve("GET", "/admin/history?limit=10", "", "secret")
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var history cosmosfaucet.HistoryResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&history))
+	require.Len(t, history.Transfers, 1)
+	require.Equal(t, "cosmos1a", history.Transfers[0].Address)
+	require.Equal(t, []string{"10token"}, history.Transfers[0].Coins)
+}
@@ -43,9 +43,25 @@ func TryRetrieve(
 
 	fc := NewClient(faucetURL.String())
 
-	resp, err := fc.Transfer(ctx, TransferRequest{
+	req := TransferRequest{
 		AccountAddress: accountAddress,
-	})
+	}
+
+	// solve a challenge when the faucet requires one.
+	if info, err := fc.FaucetInfo(ctx); err == nil && info.ChallengeDifficulty > 0 {
+		challenge, err := fc.Challenge(ctx)
+		if err != nil {
+			return errors.Wrap(err, "faucet is not operational")
+		}
+
+		req.Challenge = challenge.Challenge
+		req.Nonce, err = SolveChallenge(ctx, challenge.Challenge, accountAddress, challenge.Difficulty)
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := fc.Transfer(ctx, req)
 	if err != nil {
 		return errors.Wrap(err, "faucet is not operational")
 	}
@@ -15,9 +15,27 @@ type Saver interface {
 	Save(context.Context, []cosmosclient.TX) error
 }
 
+// Checkpoint defines the height and hash of a saved block.
+type Checkpoint struct {
+	Height int64
+	Hash   string
+}
+
+// Checkpointer is the interface for data backends that keep the hash of the saved
+// blocks and that can remove the data of blocks that no longer exist in a chain.
+type Checkpointer interface {
+	// GetCheckpoints returns the saved blocks with a height lower or equal to a height.
+	// Checkpoints are sorted by height from the newest to the oldest block.
+	GetCheckpoints(ctx context.Context, toHeight int64, limit int) ([]Checkpoint, error)
+
+	// Rollback deletes the data saved for all the blocks above a height.
+	Rollback(ctx context.Context, height int64) error
+}
+
 // Adapter defines the interface for data backend adaptors.
 type Adapter interface {
 	Saver
+	Checkpointer
 
 	// GetType returns the adapter type.
 	GetType() string
@@ -0,0 +1,530 @@
+package boltdb
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
+)
+
+const (
+	adapterType = "boltdb"
+
+	// DefaultOpenTimeout is the default time to wait for the database file lock.
+	DefaultOpenTimeout = time.Minute
+)
+
+var (
+	bucketMeta      = []byte("meta")
+	bucketTX        = []byte(entityTX)
+	bucketEvent     = []byte(entityEvent)
+	bucketRawTX     = []byte(entityRawTX)
+	bucketBlock     = []byte(entityBlock)
+	bucketMessage   = []byte(entityMessage)
+	keyVersion      = []byte("schema_version")
+	keyLatestHeight = []byte("latest_height")
+)
+
+var (
+	// ErrClosed is returned when database is not open.
+	ErrClosed = errors.New("no database connection")
+
+	// ErrNotInitialized is returned when the adapter is used before calling Init.
+	ErrNotInitialized = errors.New("database is not initialized")
+)
+
+// migration updates the database to a schema version.
+type migration func(*bolt.Tx) error
+
+// migrations contains the database migrations.
+// The position of each migration within the slice plus one is its schema version.
+var migrations = []migration{
+	// Version 1 creates the buckets for the transactions and events
+	func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{bucketTX, bucketEvent, bucketRawTX} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+	// Version 2 creates the buckets for the blocks and the transaction messages
+	func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{bucketBlock, bucketMessage} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+}
+
+// Option defines an option for the adapter.
+type Option func(*Adapter)
+
+// WithOpenTimeout configures the time to wait for the database file lock.
+func WithOpenTimeout(timeout time.Duration) Option {
+	return func(a *Adapter) {
+		a.openTimeout = timeout
+	}
+}
+
+// NewAdapter creates a new BoltDB adapter.
+// The database file is created when it doesn't exist.
+// Only one process can have the database open at the same time.
+func NewAdapter(path string, options ...Option) (Adapter, error) {
+	adapter := Adapter{
+		openTimeout: DefaultOpenTimeout,
+	}
+
+	for _, o := range options {
+		o(&adapter)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return Adapter{}, err
+	}
+
+	db, err := bolt.Open(path, 0o640, &bolt.Options{Timeout: adapter.openTimeout})
+	if err != nil {
+		return Adapter{}, err
+	}
+
+	adapter.db = db
+
+	return adapter, nil
+}
+
+// Adapter implements a data backend adapter for BoltDB.
+// BoltDB is an embedded key-value store so queries are evaluated by
+// iterating the saved values, which makes it suitable for local indexing.
+type Adapter struct {
+	openTimeout time.Duration
+	db          *bolt.DB
+}
+
+func (a Adapter) GetType() string {
+	return adapterType
+}
+
+// Init applies the database migrations that were not applied already.
+func (a Adapter) Init(context.Context) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	return db.Update(func(tx *bolt.Tx) error {
+		meta, err := tx.CreateBucketIfNotExists(bucketMeta)
+		if err != nil {
+			return fmt.Errorf("failed to check schema version: %w", err)
+		}
+
+		version := decodeUint64(meta.Get(keyVersion))
+		for v := version; v < uint64(len(migrations)); v++ {
+			if err := migrations[v](tx); err != nil {
+				return fmt.Errorf("error applying schema version %d: %w", v+1, err)
+			}
+		}
+
+		return meta.Put(keyVersion, encodeUint64(uint64(len(migrations))))
+	})
+}
+
+// Close closes the database.
+func (a Adapter) Close() error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	return db.Close()
+}
+
+func (a Adapter) Save(_ context.Context, txs []cosmosclient.TX) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	// All the transactions are saved within the context of the same database
+	// transactions and because of that either all block transactions are
+	// saved or none of them.
+	return db.Update(func(tx *bolt.Tx) error {
+		meta := tx.Bucket(bucketMeta)
+		if meta == nil {
+			return ErrNotInitialized
+		}
+
+		now := time.Now().UTC()
+		latestHeight := int64(decodeUint64(meta.Get(keyLatestHeight)))
+
+		for _, t := range txs {
+			if err := saveBlock(tx, t, now); err != nil {
+				return err
+			}
+
+			if err := saveTX(tx, t, now); err != nil {
+				return err
+			}
+
+			if t.Raw.Height > latestHeight {
+				latestHeight = t.Raw.Height
+			}
+		}
+
+		return meta.Put(keyLatestHeight, encodeUint64(uint64(latestHeight)))
+	})
+}
+
+func (a Adapter) GetLatestHeight(context.Context) (height int64, err error) {
+	db, err := a.getDB()
+	if err != nil {
+		return 0, err
+	}
+
+	err = db.View(func(tx *bolt.Tx) error {
+		if meta := tx.Bucket(bucketMeta); meta != nil {
+			height = int64(decodeUint64(meta.Get(keyLatestHeight)))
+		}
+
+		return nil
+	})
+
+	return height, err
+}
+
+func (a Adapter) GetCheckpoints(_ context.Context, toHeight int64, limit int) (checkpoints []adapter.Checkpoint, err error) {
+	db, err := a.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.View(func(tx *bolt.Tx) error {
+		blocks := tx.Bucket(bucketBlock)
+		if blocks == nil {
+			return ErrNotInitialized
+		}
+
+		// Block keys are big endian heights so they are sorted by height
+		c := blocks.Cursor()
+		k, v := c.Seek(encodeUint64(uint64(toHeight)))
+		if k == nil || int64(decodeUint64(k)) > toHeight {
+			k, v = c.Prev()
+		}
+
+		for ; k != nil && len(checkpoints) < limit; k, v = c.Prev() {
+			var r blockRecord
+			if err := json.Unmarshal(v, &r); err != nil {
+				return err
+			}
+
+			checkpoints = append(checkpoints, adapter.Checkpoint{
+				Height: r.Height,
+				Hash:   r.Hash,
+			})
+		}
+
+		return nil
+	})
+
+	return checkpoints, err
+}
+
+func (a Adapter) Rollback(_ context.Context, height int64) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	return db.Update(func(tx *bolt.Tx) error {
+		meta := tx.Bucket(bucketMeta)
+		if meta == nil {
+			return ErrNotInitialized
+		}
+
+		if err := rollback(tx, height); err != nil {
+			return fmt.Errorf("error rolling back to block %d: %w", height, err)
+		}
+
+		if latestHeight := int64(decodeUint64(meta.Get(keyLatestHeight))); latestHeight <= height {
+			return nil
+		}
+
+		return meta.Put(keyLatestHeight, encodeUint64(uint64(height)))
+	})
+}
+
+func (a Adapter) QueryEvents(ctx context.Context, q query.EventQuery) (events []query.Event, err error) {
+	db, err := a.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.View(func(tx *bolt.Tx) error {
+		events, err = queryEvents(ctx, tx, q)
+		return err
+	})
+
+	return events, err
+}
+
+func (a Adapter) Query(ctx context.Context, q query.Query) (cr query.Cursor, err error) {
+	db, err := a.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.View(func(tx *bolt.Tx) error {
+		cr, err = queryEntity(ctx, tx, q)
+		return err
+	})
+
+	return cr, err
+}
+
+func (a Adapter) getDB() (*bolt.DB, error) {
+	if a.db == nil {
+		return nil, ErrClosed
+	}
+
+	return a.db, nil
+}
+
+func saveBlock(tx *bolt.Tx, t cosmosclient.TX, now time.Time) error {
+	// Blocks are saved once for all their transactions
+	key := encodeUint64(uint64(t.Raw.Height))
+	blocks := tx.Bucket(bucketBlock)
+	if blocks.Get(key) != nil {
+		return nil
+	}
+
+	if err := putJSON(blocks, key, blockRecord{
+		Height:    t.Raw.Height,
+		Hash:      t.BlockHash,
+		Proposer:  t.BlockProposer,
+		Time:      t.BlockTime,
+		CreatedAt: now,
+	}); err != nil {
+		return fmt.Errorf("error saving block %d: %w", t.Raw.Height, err)
+	}
+
+	return nil
+}
+
+func saveTX(tx *bolt.Tx, t cosmosclient.TX, now time.Time) error {
+	hash := t.Raw.Hash.String()
+
+	txs := tx.Bucket(bucketTX)
+	if txs.Get([]byte(hash)) != nil {
+		return fmt.Errorf("error saving TX %s: TX already exists", hash)
+	}
+
+	raw, err := json.Marshal(t.Raw)
+	if err != nil {
+		return fmt.Errorf("failed to encode raw TX %s: %w", hash, err)
+	}
+
+	if err := putJSON(tx.Bucket(bucketRawTX), []byte(hash), rawTXRecord{
+		Hash:      hash,
+		Data:      string(raw),
+		CreatedAt: now,
+	}); err != nil {
+		return fmt.Errorf("error saving raw TX %s: %w", hash, err)
+	}
+
+	// TXs without fees are saved with an empty list of coins
+	fee := t.Fee
+	if fee == nil {
+		fee = sdktypes.Coins{}
+	}
+
+	feeData, err := json.Marshal(fee)
+	if err != nil {
+		return fmt.Errorf("failed to encode TX %s fee: %w", hash, err)
+	}
+
+	if err := putJSON(txs, []byte(hash), txRecord{
+		Hash:      hash,
+		Index:     int64(t.Raw.Index),
+		Height:    t.Raw.Height,
+		BlockTime: t.BlockTime,
+		GasWanted: t.Raw.TxResult.GasWanted,
+		GasUsed:   t.Raw.TxResult.GasUsed,
+		Fee:       feeData,
+		Code:      t.Raw.TxResult.Code,
+		Memo:      t.Memo,
+		CreatedAt: now,
+	}); err != nil {
+		return fmt.Errorf("error saving TX %s: %w", hash, err)
+	}
+
+	messages := tx.Bucket(bucketMessage)
+	for i, msg := range t.Messages {
+		key := append([]byte(hash), encodeUint64(uint64(i))...)
+		if err := putJSON(messages, key, messageRecord{
+			TXHash:    hash,
+			Index:     int64(i),
+			TypeURL:   msg.TypeURL,
+			Body:      msg.Body,
+			Signers:   msg.Signers,
+			CreatedAt: now,
+		}); err != nil {
+			return fmt.Errorf("error saving message '%s': %w", msg.TypeURL, err)
+		}
+	}
+
+	events, err := t.GetEvents()
+	if err != nil {
+		return err
+	}
+
+	bucket := tx.Bucket(bucketEvent)
+	for i, evt := range events {
+		id, err := bucket.NextSequence()
+		if err != nil {
+			return fmt.Errorf("error reading event ID: %w", err)
+		}
+
+		r := eventRecord{
+			ID:        int64(id),
+			TXHash:    hash,
+			Type:      evt.Type,
+			Index:     int64(i),
+			CreatedAt: now,
+			Height:    t.Raw.Height,
+			TXIndex:   int64(t.Raw.Index),
+		}
+
+		for _, attr := range evt.Attributes {
+			r.Attributes = append(r.Attributes, attributeRecord{
+				Name:  attr.Key,
+				Value: attr.Value,
+			})
+		}
+
+		if err := putJSON(bucket, encodeUint64(id), r); err != nil {
+			return fmt.Errorf("error saving event '%s': %w", evt.Type, err)
+		}
+	}
+
+	return nil
+}
+
+func rollback(tx *bolt.Tx, height int64) error {
+	// Select the hashes of the TXs to delete
+	hashes := make(map[string]struct{})
+	err := walkBucket(tx, bucketTX, func(r txRecord) error {
+		if r.Height > height {
+			hashes[r.Hash] = struct{}{}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, name := range [][]byte{bucketTX, bucketRawTX} {
+		b := tx.Bucket(name)
+		for hash := range hashes {
+			if err := b.Delete([]byte(hash)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := deleteKeys(tx.Bucket(bucketEvent), func(v []byte) (bool, error) {
+		var r eventRecord
+		if err := json.Unmarshal(v, &r); err != nil {
+			return false, err
+		}
+
+		return r.Height > height, nil
+	}); err != nil {
+		return err
+	}
+
+	if err := deleteKeys(tx.Bucket(bucketMessage), func(v []byte) (bool, error) {
+		var r messageRecord
+		if err := json.Unmarshal(v, &r); err != nil {
+			return false, err
+		}
+
+		_, ok := hashes[r.TXHash]
+		return ok, nil
+	}); err != nil {
+		return err
+	}
+
+	return deleteKeys(tx.Bucket(bucketBlock), func(v []byte) (bool, error) {
+		var r blockRecord
+		if err := json.Unmarshal(v, &r); err != nil {
+			return false, err
+		}
+
+		return r.Height > height, nil
+	})
+}
+
+// deleteKeys deletes the bucket values that match.
+// Keys are deleted after iterating the bucket because bucket values
+// can't be modified during iteration.
+func deleteKeys(b *bolt.Bucket, match func([]byte) (bool, error)) error {
+	var keys [][]byte
+	err := b.ForEach(func(k, v []byte) error {
+		ok, err := match(v)
+		if ok {
+			keys = append(keys, k)
+		}
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, k := range keys {
+		if err := b.Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func putJSON(b *bolt.Bucket, key []byte, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return b.Put(key, data)
+}
+
+func encodeUint64(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+func decodeUint64(b []byte) uint64 {
+	if len(b) != 8 {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(b)
+}
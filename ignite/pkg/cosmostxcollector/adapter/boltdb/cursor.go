@@ -0,0 +1,108 @@
+package boltdb
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrCursorClosed is returned when the cursor is used after it is closed.
+var ErrCursorClosed = errors.New("cursor is closed")
+
+func newCursor(rows [][]any) *cursor {
+	return &cursor{
+		rows: rows,
+		pos:  -1,
+	}
+}
+
+// cursor iterates query results that are already loaded into memory.
+type cursor struct {
+	rows   [][]any
+	pos    int
+	err    error
+	closed bool
+}
+
+func (c *cursor) Err() error {
+	return c.err
+}
+
+func (c *cursor) Next() bool {
+	if c.closed {
+		return false
+	}
+
+	c.pos++
+	if c.pos >= len(c.rows) {
+		c.Close()
+		return false
+	}
+
+	return true
+}
+
+func (c *cursor) Scan(values ...any) error {
+	if c.closed {
+		return ErrCursorClosed
+	}
+
+	if c.pos < 0 {
+		return errors.New("scan called without calling next")
+	}
+
+	row := c.rows[c.pos]
+	if len(values) != len(row) {
+		return fmt.Errorf("expected %d destination arguments in scan, not %d", len(row), len(values))
+	}
+
+	for i, v := range values {
+		if err := assign(v, row[i]); err != nil {
+			return fmt.Errorf("scan error on column index %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *cursor) Close() error {
+	c.closed = true
+	c.rows = nil
+	return nil
+}
+
+// assign assigns a row value to a destination pointer.
+// Values are converted when the destination type is different and the
+// conversion is possible, for example from an int64 to an int.
+func assign(dest, src any) error {
+	dv := reflect.ValueOf(dest)
+	if dv.Kind() != reflect.Pointer || dv.IsNil() {
+		return errors.New("destination is not a pointer")
+	}
+
+	dv = dv.Elem()
+	if src == nil {
+		dv.Set(reflect.Zero(dv.Type()))
+		return nil
+	}
+
+	sv := reflect.ValueOf(src)
+	if sv.Type().AssignableTo(dv.Type()) {
+		dv.Set(sv)
+		return nil
+	}
+
+	_, srcIsNumber := toFloat(src)
+	_, destIsNumber := toFloat(dv.Interface())
+	isText := func(t reflect.Type) bool {
+		return t.Kind() == reflect.String || (t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8)
+	}
+
+	// Only allow conversions between numbers or between strings and bytes
+	if ((srcIsNumber && destIsNumber) || (isText(sv.Type()) && isText(dv.Type()))) && sv.CanConvert(dv.Type()) {
+		dv.Set(sv.Convert(dv.Type()))
+		return nil
+	}
+
+	return fmt.Errorf("unsupported conversion from %T to %s", src, dv.Type())
+}
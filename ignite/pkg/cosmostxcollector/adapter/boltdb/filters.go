@@ -0,0 +1,279 @@
+package boltdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
+)
+
+const (
+	FieldBlockProposer  = "block.proposer"
+	FieldEventAttrName  = "attribute.name"
+	FieldEventAttrValue = "attribute.value"
+	FieldEventTXHash    = "event.tx_hash"
+	FieldEventType      = "event.type"
+	FieldMessageSigners = "message.signers"
+	FieldMessageTXHash  = "message.tx_hash"
+	FieldMessageType    = "message.type_url"
+	FieldTXCode         = "tx.code"
+	FieldTXFee          = "tx.fee"
+	FieldTXMemo         = "tx.memo"
+)
+
+// Matcher defines a function that checks if a field value matches the filter value.
+type Matcher func(fieldValue, filterValue any) bool
+
+// FilterOption defines an option for filters.
+type FilterOption func(*Filter)
+
+// WithMatcher assigns a custom matcher function to the filter.
+// By default filters match when the field value is equal to the filter value.
+func WithMatcher(m Matcher) FilterOption {
+	return func(f *Filter) {
+		f.matcher = m
+	}
+}
+
+// NewFilter creates a new generic equality filter.
+func NewFilter(field string, value any, options ...FilterOption) Filter {
+	f := Filter{
+		field:   field,
+		value:   value,
+		matcher: isEqual,
+	}
+
+	for _, o := range options {
+		o(&f)
+	}
+
+	return f
+}
+
+// Filter defines a generic equality filter.
+type Filter struct {
+	field   string
+	value   any
+	matcher Matcher
+}
+
+func (f Filter) String() string {
+	return fmt.Sprintf("%s = %v", f.field, f.value)
+}
+
+func (f Filter) Field() string {
+	return f.field
+}
+
+func (f Filter) Value() any {
+	return f.value
+}
+
+// Match checks if a field value matches the filter.
+func (f Filter) Match(v any) bool {
+	return f.matcher(v, f.value)
+}
+
+// NewStringSliceFilter creates a new string slice equality filter.
+func NewStringSliceFilter(field string, values []string, options ...FilterOption) SliceFilter {
+	return SliceFilter{
+		Filter: NewFilter(field, toAnySlice(values), options...),
+	}
+}
+
+// NewIntSliceFilter creates a new int64 slice equality filter.
+func NewIntSliceFilter(field string, values []int64, options ...FilterOption) SliceFilter {
+	return SliceFilter{
+		Filter: NewFilter(field, toAnySlice(values), options...),
+	}
+}
+
+// SliceFilter defines a generic slice equality filter.
+// It matches when the field value is equal to any of the slice values.
+type SliceFilter struct {
+	Filter
+}
+
+func (f SliceFilter) String() string {
+	return fmt.Sprintf("%s IN %v", f.field, f.value)
+}
+
+func (f SliceFilter) Value() any {
+	return f.Filter.Value()
+}
+
+// Match checks if a field value matches any of the filter values.
+func (f SliceFilter) Match(v any) bool {
+	values, _ := f.value.([]any)
+	for _, fv := range values {
+		if f.matcher(v, fv) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FilterByEventType creates a new filter to match events by type.
+func FilterByEventType(eventType string) Filter {
+	return NewFilter(FieldEventType, eventType)
+}
+
+// FilterByEventTXs creates a new filter to match events by TX hashes.
+func FilterByEventTXs(hashes ...string) SliceFilter {
+	return NewStringSliceFilter(FieldEventTXHash, hashes)
+}
+
+// FilterByEventAttrName creates a new filter to match events by attribute name.
+func FilterByEventAttrName(name string) Filter {
+	return NewFilter(FieldEventAttrName, name)
+}
+
+// FilterByEventAttrValue creates a new filter to match events by attribute value.
+func FilterByEventAttrValue(v string) Filter {
+	// Attribute values are JSON encoded so they are decoded before matching
+	return NewFilter(FieldEventAttrValue, v, WithMatcher(matchJSON))
+}
+
+// FilterByEventAttrValueInt creates a new filter to match events by attribute value.
+func FilterByEventAttrValueInt(v int64) Filter {
+	// Attribute values are JSON encoded so they are decoded before matching
+	return NewFilter(FieldEventAttrValue, v, WithMatcher(matchJSON))
+}
+
+// FilterByBlockProposer creates a new filter to match blocks by proposer address.
+func FilterByBlockProposer(address string) Filter {
+	return NewFilter(FieldBlockProposer, address)
+}
+
+// FilterByMessageType creates a new filter to match messages by type URL.
+func FilterByMessageType(typeURL string) Filter {
+	return NewFilter(FieldMessageType, typeURL)
+}
+
+// FilterByMessageTXs creates a new filter to match messages by TX hashes.
+func FilterByMessageTXs(hashes ...string) SliceFilter {
+	return NewStringSliceFilter(FieldMessageTXHash, hashes)
+}
+
+// FilterByMessageSigner creates a new filter to match messages signed by an address.
+func FilterByMessageSigner(address string) Filter {
+	return NewFilter(FieldMessageSigners, address, WithMatcher(matchContains))
+}
+
+// FilterByTXCode creates a new filter to match TXs by result code.
+func FilterByTXCode(code uint32) Filter {
+	return NewFilter(FieldTXCode, code)
+}
+
+// FilterByTXSuccess creates a new filter to match TXs that succeeded or failed.
+func FilterByTXSuccess(success bool) Filter {
+	// Successful TXs always have a zero result code
+	if success {
+		return FilterByTXCode(0)
+	}
+
+	return NewFilter(FieldTXCode, 0, WithMatcher(isNotEqual))
+}
+
+// FilterByTXMemo creates a new filter to match TXs by memo.
+func FilterByTXMemo(memo string) Filter {
+	return NewFilter(FieldTXMemo, memo)
+}
+
+// FilterByTXFeeDenom creates a new filter to match TXs that paid fees with a denom.
+func FilterByTXFeeDenom(denom string) Filter {
+	// Fees are JSON encoded so they are decoded before matching
+	return NewFilter(FieldTXFee, denom, WithMatcher(matchFeeDenom))
+}
+
+// matcher is implemented by the filters that can match field values.
+type matcher interface {
+	Match(any) bool
+}
+
+// matchFilter checks if a field value matches a filter.
+// Filters that don't define how to match values match by equality.
+func matchFilter(f query.Filter, v any) bool {
+	if m, ok := f.(matcher); ok {
+		return m.Match(v)
+	}
+
+	return isEqual(v, f.Value())
+}
+
+// fieldName returns the name of a field without the entity prefix.
+// Quoted field names are supported to be compatible with SQL queries.
+func fieldName(entity, field string) string {
+	return strings.Trim(strings.TrimPrefix(field, entity+"."), `"`)
+}
+
+func isEqual(fieldValue, filterValue any) bool {
+	return compare(fieldValue, filterValue) == 0
+}
+
+func isNotEqual(fieldValue, filterValue any) bool {
+	return !isEqual(fieldValue, filterValue)
+}
+
+func matchContains(fieldValue, filterValue any) bool {
+	values, ok := fieldValue.([]string)
+	if !ok {
+		return false
+	}
+
+	for _, v := range values {
+		if isEqual(v, filterValue) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchFeeDenom(fieldValue, filterValue any) bool {
+	b, ok := fieldValue.([]byte)
+	if !ok {
+		return false
+	}
+
+	var coins []struct {
+		Denom string `json:"denom"`
+	}
+
+	if err := json.Unmarshal(b, &coins); err != nil {
+		return false
+	}
+
+	for _, c := range coins {
+		if isEqual(c.Denom, filterValue) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchJSON(fieldValue, filterValue any) bool {
+	b, ok := fieldValue.([]byte)
+	if !ok {
+		return false
+	}
+
+	var v any
+	if err := json.Unmarshal(b, &v); err != nil {
+		return false
+	}
+
+	return isEqual(v, filterValue)
+}
+
+func toAnySlice[T any](values []T) []any {
+	s := make([]any, len(values))
+	for i, v := range values {
+		s[i] = v
+	}
+
+	return s
+}
@@ -0,0 +1,343 @@
+package boltdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
+)
+
+const eventAttrPrefix = "attribute."
+
+var (
+	ErrUnknownEntity         = errors.New("unknown query entity")
+	ErrUnknownField          = errors.New("unknown query field")
+	ErrInvalidSortOrder      = errors.New("invalid query sort order")
+	ErrFunctionsNotSupported = errors.New("query functions are not supported")
+)
+
+func queryEntity(ctx context.Context, tx *bolt.Tx, q query.Query) (*cursor, error) {
+	entity := q.Name()
+	allFields, ok := entityFields[entity]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEntity, entity)
+	}
+
+	// Arguments are only used to call functions in relational databases
+	if len(q.Args()) > 0 {
+		return nil, ErrFunctionsNotSupported
+	}
+
+	fields := make([]string, len(q.Fields()))
+	for i, f := range q.Fields() {
+		fields[i] = fieldName(entity, f)
+	}
+
+	if err := checkFields(allFields, fields...); err != nil {
+		return nil, err
+	}
+
+	for _, f := range q.Filters() {
+		if err := checkFields(allFields, fieldName(entity, f.Field())); err != nil {
+			return nil, err
+		}
+	}
+
+	var sortBy []query.SortBy
+	for _, s := range q.SortBy() {
+		if s.Order != query.SortOrderAsc && s.Order != query.SortOrderDesc {
+			return nil, ErrInvalidSortOrder
+		}
+
+		s.Field = fieldName(entity, s.Field)
+		if err := checkFields(allFields, s.Field); err != nil {
+			return nil, err
+		}
+
+		sortBy = append(sortBy, s)
+	}
+
+	// Select the rows that match all the filters
+	var rows []row
+	err := walkEntity(tx, entity, func(r row) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		for _, f := range q.Filters() {
+			if !matchFilter(f, r[fieldName(entity, f.Field())]) {
+				return nil
+			}
+		}
+
+		rows = append(rows, r)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, s := range sortBy {
+			c := compare(rows[i][s.Field], rows[j][s.Field])
+			if c == 0 {
+				continue
+			}
+
+			if s.Order == query.SortOrderDesc {
+				return c > 0
+			}
+
+			return c < 0
+		}
+
+		return false
+	})
+
+	// By default select all fields, otherwise select only distinct values
+	// to keep the same semantics than relational databases.
+	if len(fields) == 0 {
+		fields = allFields
+	} else {
+		rows = distinct(rows, fields)
+	}
+
+	values := make([][]any, len(rows))
+	for i, r := range rows {
+		values[i] = make([]any, len(fields))
+		for j, f := range fields {
+			values[i][j] = r[f]
+		}
+	}
+
+	return newCursor(paginate(q, values)), nil
+}
+
+func queryEvents(ctx context.Context, tx *bolt.Tx, q query.EventQuery) ([]query.Event, error) {
+	var eventFilters, attrFilters []query.Filter
+
+	// Split the filters that must match the event from
+	// the ones that must match one of its attributes.
+	for _, f := range q.Filters() {
+		if strings.HasPrefix(f.Field(), eventAttrPrefix) {
+			attrFilters = append(attrFilters, f)
+		} else {
+			eventFilters = append(eventFilters, f)
+		}
+	}
+
+	var records []eventRecord
+	err := walkBucket(tx, bucketEvent, func(r eventRecord) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if matchEvent(r, eventFilters, attrFilters) {
+			records = append(records, r)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort the events in the same order they happened
+	sort.SliceStable(records, func(i, j int) bool {
+		a, b := records[i], records[j]
+		if a.Height != b.Height {
+			return a.Height < b.Height
+		}
+
+		if a.TXIndex != b.TXIndex {
+			return a.TXIndex < b.TXIndex
+		}
+
+		return a.Index < b.Index
+	})
+
+	var events []query.Event
+	for _, r := range paginate(q, records) {
+		e := query.Event{
+			ID:        r.ID,
+			TXHash:    r.TXHash,
+			Index:     uint64(r.Index),
+			Type:      r.Type,
+			CreatedAt: r.CreatedAt,
+		}
+
+		for _, a := range r.Attributes {
+			e.Attributes = append(e.Attributes, query.NewAttribute(a.Name, a.Value))
+		}
+
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+func matchEvent(r eventRecord, eventFilters, attrFilters []query.Filter) bool {
+	values := r.row()
+	for _, f := range eventFilters {
+		if !matchFilter(f, values[fieldName(entityEvent, f.Field())]) {
+			return false
+		}
+	}
+
+	if len(attrFilters) == 0 {
+		return true
+	}
+
+	// At least one of the event attributes must match all the attribute filters
+	for _, a := range r.Attributes {
+		values := r.attributeRow(a)
+		matches := true
+
+		for _, f := range attrFilters {
+			if !matchFilter(f, values[fieldName(entityAttribute, f.Field())]) {
+				matches = false
+				break
+			}
+		}
+
+		if matches {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkFields(allFields []string, fields ...string) error {
+	for _, f := range fields {
+		found := false
+		for _, name := range allFields {
+			if f == name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("%w: %s", ErrUnknownField, f)
+		}
+	}
+
+	return nil
+}
+
+func distinct(rows []row, fields []string) []row {
+	var (
+		result []row
+		seen   = make(map[string]struct{})
+	)
+
+	for _, r := range rows {
+		values := make([]any, len(fields))
+		for i, f := range fields {
+			values[i] = r[f]
+		}
+
+		key := fmt.Sprintf("%#v", values)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+
+		seen[key] = struct{}{}
+		result = append(result, r)
+	}
+
+	return result
+}
+
+func paginate[T any](q query.Pager, values []T) []T {
+	if !q.IsPagingEnabled() {
+		return values
+	}
+
+	// Get the current page and make sure that the page number is valid
+	page := q.AtPage()
+	if page == 0 {
+		page = 1
+	}
+
+	limit := int(q.PageSize())
+	offset := limit * int(page-1)
+	if offset >= len(values) {
+		return nil
+	}
+
+	if end := offset + limit; end < len(values) {
+		return values[offset:end]
+	}
+
+	return values[offset:]
+}
+
+// compare compares two values and returns zero when they are equal,
+// a negative number when a is less than b or a positive number otherwise.
+// Numeric values are compared by value independently of their type.
+func compare(a, b any) int {
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			switch {
+			case fa < fb:
+				return -1
+			case fa > fb:
+				return 1
+			}
+
+			return 0
+		}
+	}
+
+	if ta, ok := a.(time.Time); ok {
+		if tb, ok := b.(time.Time); ok {
+			switch {
+			case ta.Before(tb):
+				return -1
+			case ta.After(tb):
+				return 1
+			}
+
+			return 0
+		}
+	}
+
+	return strings.Compare(toString(a), toString(b))
+}
+
+func toFloat(v any) (float64, bool) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+
+	return 0, false
+}
+
+func toString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	}
+
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,217 @@
+package boltdb
+
+import (
+	"encoding/json"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	entityTX        = "tx"
+	entityEvent     = "event"
+	entityAttribute = "attribute"
+	entityRawTX     = "raw_tx"
+	entityBlock     = "block"
+	entityMessage   = "message"
+)
+
+// row is a queried entity value indexed by field name.
+type row map[string]any
+
+// entityFields contains the fields of each entity that can be queried.
+// The order of the fields is the order used to select all the fields.
+var entityFields = map[string][]string{
+	entityTX: {
+		"hash", "index", "height", "block_time", "gas_wanted",
+		"gas_used", "fee", "code", "memo", "created_at",
+	},
+	entityEvent:     {"id", "tx_hash", "type", "index", "created_at"},
+	entityAttribute: {"event_id", "name", "value", "created_at"},
+	entityRawTX:     {"hash", "data", "created_at"},
+	entityBlock:     {"height", "hash", "proposer", "time", "created_at"},
+	entityMessage:   {"tx_hash", "index", "type_url", "body", "signers", "created_at"},
+}
+
+type txRecord struct {
+	Hash      string          `json:"hash"`
+	Index     int64           `json:"index"`
+	Height    int64           `json:"height"`
+	BlockTime time.Time       `json:"block_time"`
+	GasWanted int64           `json:"gas_wanted"`
+	GasUsed   int64           `json:"gas_used"`
+	Fee       json.RawMessage `json:"fee"`
+	Code      uint32          `json:"code"`
+	Memo      string          `json:"memo"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func (r txRecord) row() row {
+	// TXs saved before the fees were available don't have fees
+	fee := []byte(r.Fee)
+	if fee == nil {
+		fee = []byte("[]")
+	}
+
+	return row{
+		"hash":       r.Hash,
+		"index":      r.Index,
+		"height":     r.Height,
+		"block_time": r.BlockTime,
+		"gas_wanted": r.GasWanted,
+		"gas_used":   r.GasUsed,
+		"fee":        fee,
+		"code":       r.Code,
+		"memo":       r.Memo,
+		"created_at": r.CreatedAt,
+	}
+}
+
+type blockRecord struct {
+	Height    int64     `json:"height"`
+	Hash      string    `json:"hash"`
+	Proposer  string    `json:"proposer"`
+	Time      time.Time `json:"time"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (r blockRecord) row() row {
+	return row{
+		"height":     r.Height,
+		"hash":       r.Hash,
+		"proposer":   r.Proposer,
+		"time":       r.Time,
+		"created_at": r.CreatedAt,
+	}
+}
+
+type messageRecord struct {
+	TXHash  string `json:"tx_hash"`
+	Index   int64  `json:"index"`
+	TypeURL string `json:"type_url"`
+
+	// Body is the JSON encoded message.
+	Body      json.RawMessage `json:"body"`
+	Signers   []string        `json:"signers"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func (r messageRecord) row() row {
+	return row{
+		"tx_hash":    r.TXHash,
+		"index":      r.Index,
+		"type_url":   r.TypeURL,
+		"body":       []byte(r.Body),
+		"signers":    r.Signers,
+		"created_at": r.CreatedAt,
+	}
+}
+
+type eventRecord struct {
+	ID         int64             `json:"id"`
+	TXHash     string            `json:"tx_hash"`
+	Type       string            `json:"type"`
+	Index      int64             `json:"index"`
+	CreatedAt  time.Time         `json:"created_at"`
+	Attributes []attributeRecord `json:"attributes"`
+
+	// Height and TXIndex are the height and index of the event transaction.
+	// They are used to sort events in the same order they happened.
+	Height  int64 `json:"height"`
+	TXIndex int64 `json:"tx_index"`
+}
+
+func (r eventRecord) row() row {
+	return row{
+		"id":         r.ID,
+		"tx_hash":    r.TXHash,
+		"type":       r.Type,
+		"index":      r.Index,
+		"created_at": r.CreatedAt,
+	}
+}
+
+func (r eventRecord) attributeRow(a attributeRecord) row {
+	return row{
+		"event_id":   r.ID,
+		"name":       a.Name,
+		"value":      []byte(a.Value),
+		"created_at": r.CreatedAt,
+	}
+}
+
+type attributeRecord struct {
+	Name string `json:"name"`
+
+	// Value is the JSON encoded attribute value.
+	Value json.RawMessage `json:"value"`
+}
+
+type rawTXRecord struct {
+	Hash      string    `json:"hash"`
+	Data      string    `json:"data"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (r rawTXRecord) row() row {
+	return row{
+		"hash":       r.Hash,
+		"data":       r.Data,
+		"created_at": r.CreatedAt,
+	}
+}
+
+// walkEntity calls fn for each value of an entity.
+func walkEntity(tx *bolt.Tx, entity string, fn func(row) error) error {
+	switch entity {
+	case entityTX:
+		return walkBucket(tx, bucketTX, func(r txRecord) error {
+			return fn(r.row())
+		})
+	case entityEvent:
+		return walkBucket(tx, bucketEvent, func(r eventRecord) error {
+			return fn(r.row())
+		})
+	case entityAttribute:
+		return walkBucket(tx, bucketEvent, func(r eventRecord) error {
+			for _, a := range r.Attributes {
+				if err := fn(r.attributeRow(a)); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	case entityRawTX:
+		return walkBucket(tx, bucketRawTX, func(r rawTXRecord) error {
+			return fn(r.row())
+		})
+	case entityBlock:
+		return walkBucket(tx, bucketBlock, func(r blockRecord) error {
+			return fn(r.row())
+		})
+	case entityMessage:
+		return walkBucket(tx, bucketMessage, func(r messageRecord) error {
+			return fn(r.row())
+		})
+	}
+
+	return ErrUnknownEntity
+}
+
+// walkBucket decodes each bucket value and calls fn with it.
+func walkBucket[T any](tx *bolt.Tx, name []byte, fn func(T) error) error {
+	b := tx.Bucket(name)
+	if b == nil {
+		return ErrNotInitialized
+	}
+
+	return b.ForEach(func(_, data []byte) error {
+		var r T
+		if err := json.Unmarshal(data, &r); err != nil {
+			return err
+		}
+
+		return fn(r)
+	})
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -8,14 +9,25 @@ import (
 )
 
 const (
+	FieldBlockProposer  = "block.proposer"
 	FieldEventAttrName  = "attribute.name"
 	FieldEventAttrValue = "attribute.value"
 	FieldEventTXHash    = "event.tx_hash"
 	FieldEventType      = "event.type"
+	FieldMessageSigners = "message.signers"
+	FieldMessageTXHash  = "message.tx_hash"
+	FieldMessageType    = "message.type_url"
+	FieldTXCode         = "tx.code"
+	FieldTXFee          = "tx.fee"
+	FieldTXMemo         = "tx.memo"
 )
 
 const (
 	filterPlaceholder = "?"
+
+	OperatorEqual    = "="
+	OperatorNotEqual = "<>"
+	OperatorContains = "@>"
 )
 
 // Modifier defines a function that can be used to modify a field name or value.
@@ -42,11 +54,20 @@ func WithModifiers(m ...Modifier) FilterOption {
 	}
 }
 
+// WithOperator assigns the operator used to compare the field with the filter value.
+// By default filters compare values by equality.
+func WithOperator(op string) FilterOption {
+	return func(f *Filter) {
+		f.operator = op
+	}
+}
+
 // NewFilter creates a new generic equality filter.
 func NewFilter(field string, value any, options ...FilterOption) Filter {
 	f := Filter{
-		field: field,
-		value: value,
+		field:    field,
+		value:    value,
+		operator: OperatorEqual,
 	}
 
 	for _, o := range options {
@@ -60,11 +81,12 @@ func NewFilter(field string, value any, options ...FilterOption) Filter {
 type Filter struct {
 	field     string
 	value     any
+	operator  string
 	modifiers []Modifier
 }
 
 func (f Filter) String() string {
-	return fmt.Sprintf("%s = %s", f.applyModifiers(f.field), filterPlaceholder)
+	return fmt.Sprintf("%s %s %s", f.applyModifiers(f.field), f.operator, filterPlaceholder)
 }
 
 func (f Filter) Field() string {
@@ -111,6 +133,27 @@ func (f SliceFilter) Value() any {
 	return f.Filter.Value()
 }
 
+// NewArrayContainsFilter creates a new filter that matches when an array field contains a value.
+func NewArrayContainsFilter(field string, value any, options ...FilterOption) ArrayContainsFilter {
+	return ArrayContainsFilter{
+		Filter: NewFilter(field, value, options...),
+	}
+}
+
+// ArrayContainsFilter defines a filter for array fields.
+// It matches when any of the array field values is equal to the filter value.
+type ArrayContainsFilter struct {
+	Filter
+}
+
+func (f ArrayContainsFilter) String() string {
+	return fmt.Sprintf("%s = ANY(%s)", filterPlaceholder, f.applyModifiers(f.field))
+}
+
+func (f ArrayContainsFilter) Value() any {
+	return f.Filter.Value()
+}
+
 // FilterByEventType creates a new filter to match events by type.
 func FilterByEventType(eventType string) Filter {
 	return NewFilter(FieldEventType, eventType)
@@ -140,3 +183,52 @@ func FilterByEventAttrValueInt(v int64) Filter {
 	// Use a field modifier to cast the event attribute value JSONB field to numeric
 	return NewFilter(FieldEventAttrValue, v, WithModifiers(CastJSONToNumeric))
 }
+
+// FilterByBlockProposer creates a new filter to match blocks by proposer address.
+func FilterByBlockProposer(address string) Filter {
+	return NewFilter(FieldBlockProposer, address)
+}
+
+// FilterByMessageType creates a new filter to match messages by type URL.
+func FilterByMessageType(typeURL string) Filter {
+	return NewFilter(FieldMessageType, typeURL)
+}
+
+// FilterByMessageTXs creates a new filter to match messages by TX hashes.
+func FilterByMessageTXs(hashes ...string) SliceFilter {
+	return NewStringSliceFilter(FieldMessageTXHash, hashes)
+}
+
+// FilterByMessageSigner creates a new filter to match messages signed by an address.
+func FilterByMessageSigner(address string) ArrayContainsFilter {
+	return NewArrayContainsFilter(FieldMessageSigners, address)
+}
+
+// FilterByTXCode creates a new filter to match TXs by result code.
+func FilterByTXCode(code uint32) Filter {
+	return NewFilter(FieldTXCode, code)
+}
+
+// FilterByTXSuccess creates a new filter to match TXs that succeeded or failed.
+func FilterByTXSuccess(success bool) Filter {
+	// Successful TXs always have a zero result code
+	if success {
+		return FilterByTXCode(0)
+	}
+
+	return NewFilter(FieldTXCode, 0, WithOperator(OperatorNotEqual))
+}
+
+// FilterByTXMemo creates a new filter to match TXs by memo.
+func FilterByTXMemo(memo string) Filter {
+	return NewFilter(FieldTXMemo, memo)
+}
+
+// FilterByTXFeeDenom creates a new filter to match TXs that paid fees with a denom.
+func FilterByTXFeeDenom(denom string) Filter {
+	// Check that the fee JSONB array contains a coin with the denom.
+	// Encoding a slice of string maps can't fail.
+	v, _ := json.Marshal([]map[string]string{{"denom": denom}})
+
+	return NewFilter(FieldTXFee, string(v), WithOperator(OperatorContains))
+}
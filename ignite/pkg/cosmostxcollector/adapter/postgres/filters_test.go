@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/postgres"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
 )
 
 func TestFilter(t *testing.T) {
@@ -50,3 +51,62 @@ func TestFilterModifiers(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterHelpers(t *testing.T) {
+	cases := []struct {
+		name      string
+		filter    query.Filter
+		wantExpr  string
+		wantValue any
+	}{
+		{
+			name:      "FilterByMessageType",
+			filter:    postgres.FilterByMessageType("/cosmos.bank.v1beta1.MsgSend"),
+			wantExpr:  "message.type_url = ?",
+			wantValue: "/cosmos.bank.v1beta1.MsgSend",
+		},
+		{
+			name:      "FilterByMessageSigner",
+			filter:    postgres.FilterByMessageSigner("cosmos1a"),
+			wantExpr:  "? = ANY(message.signers)",
+			wantValue: "cosmos1a",
+		},
+		{
+			name:      "FilterByTXSuccess",
+			filter:    postgres.FilterByTXSuccess(true),
+			wantExpr:  "tx.code = ?",
+			wantValue: uint32(0),
+		},
+		{
+			name:      "FilterByTXSuccess failed",
+			filter:    postgres.FilterByTXSuccess(false),
+			wantExpr:  "tx.code <> ?",
+			wantValue: 0,
+		},
+		{
+			name:      "FilterByTXMemo",
+			filter:    postgres.FilterByTXMemo("memo"),
+			wantExpr:  "tx.memo = ?",
+			wantValue: "memo",
+		},
+		{
+			name:      "FilterByTXFeeDenom",
+			filter:    postgres.FilterByTXFeeDenom("token"),
+			wantExpr:  "tx.fee @> ?",
+			wantValue: `[{"denom":"token"}]`,
+		},
+		{
+			name:      "FilterByBlockProposer",
+			filter:    postgres.FilterByBlockProposer("ABC"),
+			wantExpr:  "block.proposer = ?",
+			wantValue: "ABC",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantExpr, tt.filter.String())
+			require.Equal(t, tt.wantValue, tt.filter.Value())
+		})
+	}
+}
@@ -8,11 +8,14 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strings"
 
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lib/pq"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
 	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
 )
 
@@ -28,14 +31,37 @@ const (
 		SELECT COALESCE(MAX(height), 0)
 		FROM tx
 	`
+	sqlSelectCheckpoints = `
+		SELECT height, hash FROM block
+		WHERE height <= $1
+		ORDER BY height DESC
+		LIMIT $2
+	`
 	sqlSelectEventAttrs = `
 		SELECT event_id, name, value FROM attribute
 		WHERE event_id = ANY($1)
 		ORDER BY event_id
 	`
-	sqlInsertTX = `
-		INSERT INTO tx (hash, index, height, block_time)
+	sqlDeleteRawTXs = `
+		DELETE FROM raw_tx
+		WHERE hash IN (SELECT hash FROM tx WHERE height > $1)
+	`
+	sqlDeleteTXs = `
+		DELETE FROM tx
+		WHERE height > $1
+	`
+	sqlDeleteBlocks = `
+		DELETE FROM block
+		WHERE height > $1
+	`
+	sqlInsertBlock = `
+		INSERT INTO block (height, hash, proposer, time)
 		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (height) DO NOTHING
+	`
+	sqlInsertTX = `
+		INSERT INTO tx (hash, index, height, block_time, gas_wanted, gas_used, fee, code, memo)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
 	sqlInsertEvent = `
 		INSERT INTO event (tx_hash, type, index)
@@ -49,6 +75,10 @@ const (
 		INSERT INTO raw_tx (hash, data)
 		VALUES ($1, $2)
 	`
+	sqlInsertMessage = `
+		INSERT INTO message (tx_hash, index, type_url, body, signers)
+		VALUES ($1, $2, $3, $4, $5)
+	`
 )
 
 //go:embed schemas/*
@@ -200,10 +230,27 @@ func (a Adapter) Save(ctx context.Context, txs []cosmosclient.TX) error {
 
 	defer attrStmt.Close()
 
+	msgStmt, err := sqlTx.PrepareContext(ctx, sqlInsertMessage)
+	if err != nil {
+		return err
+	}
+
+	defer msgStmt.Close()
+
 	// All the transactions are saved within the context of the same database
 	// transactions and because of that either all block transactions are
 	// saved or none of them.
+	var height int64
 	for _, tx := range txs {
+		// Save the block once for all its transactions
+		if tx.Raw.Height != height {
+			if err := saveBlock(ctx, sqlTx, tx); err != nil {
+				return err
+			}
+
+			height = tx.Raw.Height
+		}
+
 		if err := saveRawTX(ctx, sqlTx, tx.Raw); err != nil {
 			return err
 		}
@@ -211,6 +258,10 @@ func (a Adapter) Save(ctx context.Context, txs []cosmosclient.TX) error {
 		if err := saveTX(ctx, txStmt, evtStmt, attrStmt, tx); err != nil {
 			return err
 		}
+
+		if err := saveMessages(ctx, msgStmt, tx); err != nil {
+			return err
+		}
 	}
 
 	return sqlTx.Commit()
@@ -230,6 +281,58 @@ func (a Adapter) GetLatestHeight(ctx context.Context) (height int64, err error)
 	return height, nil
 }
 
+func (a Adapter) GetCheckpoints(ctx context.Context, toHeight int64, limit int) ([]adapter.Checkpoint, error) {
+	db, err := a.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, sqlSelectCheckpoints, toHeight, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var checkpoints []adapter.Checkpoint
+	for rows.Next() {
+		var cp adapter.Checkpoint
+		if err := rows.Scan(&cp.Height, &cp.Hash); err != nil {
+			return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+		}
+
+		// Hashes are padded with spaces when they are shorter than the column
+		cp.Hash = strings.TrimSpace(cp.Hash)
+		checkpoints = append(checkpoints, cp)
+	}
+
+	return checkpoints, rows.Err()
+}
+
+func (a Adapter) Rollback(ctx context.Context, height int64) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	sqlTx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// Rollback won't have any effect if the transaction is committed before
+	defer sqlTx.Rollback()
+
+	// Events, attributes and messages are deleted in cascade with the TXs
+	for _, sql := range []string{sqlDeleteRawTXs, sqlDeleteTXs, sqlDeleteBlocks} {
+		if _, err := sqlTx.ExecContext(ctx, sql, height); err != nil {
+			return fmt.Errorf("error rolling back to block %d: %w", height, err)
+		}
+	}
+
+	return sqlTx.Commit()
+}
+
 func (a Adapter) QueryEvents(ctx context.Context, q query.EventQuery) ([]query.Event, error) {
 	db, err := a.getDB()
 	if err != nil {
@@ -350,6 +453,15 @@ func createPostgresURI(a Adapter) string {
 	return uri.String()
 }
 
+func saveBlock(ctx context.Context, sqlTx *sql.Tx, tx cosmosclient.TX) error {
+	height := tx.Raw.Height
+	if _, err := sqlTx.ExecContext(ctx, sqlInsertBlock, height, tx.BlockHash, tx.BlockProposer, tx.BlockTime); err != nil {
+		return fmt.Errorf("error saving block %d: %w", height, err)
+	}
+
+	return nil
+}
+
 func saveRawTX(ctx context.Context, sqlTx *sql.Tx, rtx *ctypes.ResultTx) error {
 	hash := rtx.Hash.String()
 	raw, err := json.Marshal(rtx)
@@ -366,7 +478,24 @@ func saveRawTX(ctx context.Context, sqlTx *sql.Tx, rtx *ctypes.ResultTx) error {
 
 func saveTX(ctx context.Context, txStmt, evtStmt, attrStmt *sql.Stmt, tx cosmosclient.TX) error {
 	hash := tx.Raw.Hash.String()
-	if _, err := txStmt.ExecContext(ctx, hash, tx.Raw.Index, tx.Raw.Height, tx.BlockTime); err != nil {
+	fee, err := encodeFee(tx.Fee)
+	if err != nil {
+		return fmt.Errorf("failed to encode TX %s fee: %w", hash, err)
+	}
+
+	res := tx.Raw.TxResult
+	if _, err := txStmt.ExecContext(
+		ctx,
+		hash,
+		tx.Raw.Index,
+		tx.Raw.Height,
+		tx.BlockTime,
+		res.GasWanted,
+		res.GasUsed,
+		fee,
+		res.Code,
+		tx.Memo,
+	); err != nil {
 		return fmt.Errorf("error saving TX %s: %w", hash, err)
 	}
 
@@ -397,6 +526,32 @@ func saveTX(ctx context.Context, txStmt, evtStmt, attrStmt *sql.Stmt, tx cosmosc
 	return nil
 }
 
+func saveMessages(ctx context.Context, msgStmt *sql.Stmt, tx cosmosclient.TX) error {
+	hash := tx.Raw.Hash.String()
+	for i, msg := range tx.Messages {
+		// Signers can't be NULL
+		signers := msg.Signers
+		if signers == nil {
+			signers = []string{}
+		}
+
+		if _, err := msgStmt.ExecContext(ctx, hash, i, msg.TypeURL, []byte(msg.Body), pq.Array(signers)); err != nil {
+			return fmt.Errorf("error saving message '%s': %w", msg.TypeURL, err)
+		}
+	}
+
+	return nil
+}
+
+func encodeFee(fee sdktypes.Coins) ([]byte, error) {
+	// TXs without fees are saved with an empty list of coins
+	if fee == nil {
+		fee = sdktypes.Coins{}
+	}
+
+	return json.Marshal(fee)
+}
+
 func extractQueryArgs(q query.Query) []any {
 	// When the query is a call to a postgres function
 	// add the arguments before the filter values
@@ -12,12 +12,14 @@ import (
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	adapterpkg "github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
 	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
 )
 
@@ -103,15 +105,28 @@ func TestSave(t *testing.T) {
 		Attributes: []abci.EventAttribute{evtAttr},
 	}
 
+	msg := cosmosclient.TXMessage{
+		TypeURL: "/cosmos.bank.v1beta1.MsgSend",
+		Body:    []byte(`{"from_address":"cosmos1a"}`),
+		Signers: []string{"cosmos1a"},
+	}
+
 	h, _ := hex.DecodeString(hash) // TODO: How to properly generate TX hash for the result?
 	tx := cosmosclient.TX{
+		BlockHash:     "BLOCK",
+		BlockProposer: "PROPOSER",
+		Memo:          "memo",
+		Fee:           sdktypes.NewCoins(sdktypes.NewInt64Coin("token", 10)),
+		Messages:      []cosmosclient.TXMessage{msg},
 		// Tendermint API search result
 		Raw: &ctypes.ResultTx{
 			Hash:   h,
 			Height: 1,
 			Index:  0,
 			TxResult: abci.ResponseDeliverTx{
-				Events: []abci.Event{evt},
+				GasWanted: 200,
+				GasUsed:   100,
+				Events:    []abci.Event{evt},
 			},
 		},
 	}
@@ -124,8 +139,8 @@ func TestSave(t *testing.T) {
 	mock.ExpectBegin()
 
 	txStmt := mock.ExpectPrepare(`
-		INSERT INTO tx (hash, index, height, block_time)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO tx (hash, index, height, block_time, gas_wanted, gas_used, fee, code, memo)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`)
 	evtStmt := mock.ExpectPrepare(`
 		INSERT INTO event (tx_hash, type, index)
@@ -135,6 +150,10 @@ func TestSave(t *testing.T) {
 		INSERT INTO attribute (event_id, name, value)
 		VALUES ($1, $2, $3)
 	`)
+	msgStmt := mock.ExpectPrepare(`
+		INSERT INTO message (tx_hash, index, type_url, body, signers)
+		VALUES ($1, $2, $3, $4, $5)
+	`)
 
 	// Arrange: Database mock and expectations for INSERT statement executions
 	insertResult := sqlmock.NewResult(0, 1)
@@ -142,6 +161,14 @@ func TestSave(t *testing.T) {
 	evtID := int64(1)
 	jsonEvtAttrValue := []byte(fmt.Sprintf(`"%s"`, evtAttr.Value))
 
+	mock.
+		ExpectExec(`
+			INSERT INTO block (height, hash, proposer, time)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (height) DO NOTHING
+		`).
+		WithArgs(tx.Raw.Height, tx.BlockHash, tx.BlockProposer, tx.BlockTime).
+		WillReturnResult(insertResult)
 	mock.
 		ExpectExec(`
 			INSERT INTO raw_tx (hash, data)
@@ -152,7 +179,17 @@ func TestSave(t *testing.T) {
 
 	txStmt.
 		ExpectExec().
-		WithArgs(hash, tx.Raw.Index, tx.Raw.Height, tx.BlockTime).
+		WithArgs(
+			hash,
+			tx.Raw.Index,
+			tx.Raw.Height,
+			tx.BlockTime,
+			tx.Raw.TxResult.GasWanted,
+			tx.Raw.TxResult.GasUsed,
+			[]byte(`[{"denom":"token","amount":"10"}]`),
+			tx.Raw.TxResult.Code,
+			tx.Memo,
+		).
 		WillReturnResult(insertResult)
 	evtStmt.
 		ExpectQuery().
@@ -164,6 +201,10 @@ func TestSave(t *testing.T) {
 		ExpectExec().
 		WithArgs(evtID, string(evtAttr.Key), jsonEvtAttrValue).
 		WillReturnResult(insertResult)
+	msgStmt.
+		ExpectExec().
+		WithArgs(hash, 0, msg.TypeURL, []byte(msg.Body), pq.Array(msg.Signers)).
+		WillReturnResult(insertResult)
 
 	mock.ExpectCommit()
 
@@ -201,6 +242,95 @@ func TestGetLatestHeight(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGetCheckpoints(t *testing.T) {
+	// Arrange
+	db, mock := createMatchEqualSQLMock(t)
+	defer db.Close()
+
+	adapter := Adapter{db: db}
+	ctx := context.Background()
+
+	// Arrange: Database mock and expectations
+	mock.
+		ExpectQuery(sqlSelectCheckpoints).
+		WithArgs(int64(42), 2).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"height", "hash"}).
+				AddRow(int64(42), "FOO   ").
+				AddRow(int64(41), "BAR   "),
+		)
+
+	// Act
+	checkpoints, err := adapter.GetCheckpoints(ctx, 42, 2)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []adapterpkg.Checkpoint{
+		{Height: 42, Hash: "FOO"},
+		{Height: 41, Hash: "BAR"},
+	}, checkpoints)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRollback(t *testing.T) {
+	// Arrange
+	db, mock := createMatchEqualSQLMock(t)
+	defer db.Close()
+
+	adapter := Adapter{db: db}
+	ctx := context.Background()
+	height := int64(42)
+
+	// Arrange: Database mock and expectations
+	mock.ExpectBegin()
+	mock.
+		ExpectExec(sqlDeleteRawTXs).
+		WithArgs(height).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.
+		ExpectExec(sqlDeleteTXs).
+		WithArgs(height).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.
+		ExpectExec(sqlDeleteBlocks).
+		WithArgs(height).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Act
+	err := adapter.Rollback(ctx, height)
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRollbackError(t *testing.T) {
+	// Arrange
+	db, mock := createMatchEqualSQLMock(t)
+	defer db.Close()
+
+	adapter := Adapter{db: db}
+	ctx := context.Background()
+	height := int64(42)
+	wantErr := errors.New("expected error")
+
+	// Arrange: Database mock and expectations
+	mock.ExpectBegin()
+	mock.
+		ExpectExec(sqlDeleteRawTXs).
+		WithArgs(height).
+		WillReturnError(wantErr)
+	mock.ExpectRollback()
+
+	// Act
+	err := adapter.Rollback(ctx, height)
+
+	// Assert
+	require.ErrorIs(t, err, wantErr)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestQuery(t *testing.T) {
 	// Arrange
 	var rowValue string
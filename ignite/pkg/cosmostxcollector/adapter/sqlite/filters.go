@@ -0,0 +1,246 @@
+package sqlite
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	FieldBlockProposer  = "block.proposer"
+	FieldEventAttrName  = "attribute.name"
+	FieldEventAttrValue = "attribute.value"
+	FieldEventTXHash    = "event.tx_hash"
+	FieldEventType      = "event.type"
+	FieldMessageSigners = "message.signers"
+	FieldMessageTXHash  = "message.tx_hash"
+	FieldMessageType    = "message.type_url"
+	FieldTXCode         = "tx.code"
+	FieldTXFee          = "tx.fee"
+	FieldTXMemo         = "tx.memo"
+)
+
+const (
+	filterPlaceholder = "?"
+
+	OperatorEqual    = "="
+	OperatorNotEqual = "<>"
+)
+
+// Modifier defines a function that can be used to modify a field name or value.
+type Modifier func(field string) string
+
+// CastJSONToText modifier casts a JSON field to its minified JSON text.
+func CastJSONToText(f string) string {
+	return fmt.Sprintf("json(%s)", f)
+}
+
+// CastJSONToNumeric modifier casts a JSON encoded field to numeric.
+func CastJSONToNumeric(f string) string {
+	return fmt.Sprintf("CAST(%s AS NUMERIC)", f)
+}
+
+// ExtractFeeDenoms modifier extracts the denoms of a JSON array of coins.
+func ExtractFeeDenoms(f string) string {
+	return fmt.Sprintf("(SELECT json_group_array(json_extract(value, '$.denom')) FROM json_each(%s))", f)
+}
+
+// FilterOption defines an option for filters.
+type FilterOption func(*Filter)
+
+// WithModifiers assigns one or more field modifier functions to the filter.
+// Field modifiers can be used to change the behavior of a filtered field.
+func WithModifiers(m ...Modifier) FilterOption {
+	return func(f *Filter) {
+		f.modifiers = m
+	}
+}
+
+// WithOperator assigns the operator used to compare the field with the filter value.
+// By default filters compare values by equality.
+func WithOperator(op string) FilterOption {
+	return func(f *Filter) {
+		f.operator = op
+	}
+}
+
+// NewFilter creates a new generic equality filter.
+func NewFilter(field string, value any, options ...FilterOption) Filter {
+	f := Filter{
+		field:    field,
+		value:    value,
+		operator: OperatorEqual,
+	}
+
+	for _, o := range options {
+		o(&f)
+	}
+
+	return f
+}
+
+// Filter defines a generic equality filter.
+type Filter struct {
+	field     string
+	value     any
+	operator  string
+	modifiers []Modifier
+}
+
+func (f Filter) String() string {
+	return fmt.Sprintf("%s %s %s", f.applyModifiers(f.field), f.operator, filterPlaceholder)
+}
+
+func (f Filter) Field() string {
+	return f.field
+}
+
+func (f Filter) Value() any {
+	return f.value
+}
+
+func (f Filter) applyModifiers(field string) string {
+	// Apply all the field modifiers in order
+	for _, m := range f.modifiers {
+		field = m(field)
+	}
+
+	return field
+}
+
+// NewStringSliceFilter creates a new string slice equality filter.
+func NewStringSliceFilter(field string, values []string, options ...FilterOption) SliceFilter {
+	return SliceFilter{
+		Filter: NewFilter(field, encodeJSONArray(values), options...),
+	}
+}
+
+// NewIntSliceFilter creates a new int64 slice equality filter.
+func NewIntSliceFilter(field string, values []int64, options ...FilterOption) SliceFilter {
+	return SliceFilter{
+		Filter: NewFilter(field, encodeJSONArray(values), options...),
+	}
+}
+
+// SliceFilter defines a generic slice equality filter.
+// SQLite doesn't support arrays so the values are passed as a JSON array.
+type SliceFilter struct {
+	Filter
+}
+
+func (f SliceFilter) String() string {
+	return fmt.Sprintf("%s IN (SELECT value FROM json_each(%s))", f.applyModifiers(f.field), filterPlaceholder)
+}
+
+func (f SliceFilter) Value() any {
+	return f.Filter.Value()
+}
+
+// NewArrayContainsFilter creates a new filter that matches when an array field contains a value.
+func NewArrayContainsFilter(field string, value any, options ...FilterOption) ArrayContainsFilter {
+	return ArrayContainsFilter{
+		Filter: NewFilter(field, value, options...),
+	}
+}
+
+// ArrayContainsFilter defines a filter for array fields.
+// SQLite doesn't support arrays so the field must contain a JSON array.
+type ArrayContainsFilter struct {
+	Filter
+}
+
+func (f ArrayContainsFilter) String() string {
+	return fmt.Sprintf("%s IN (SELECT value FROM json_each(%s))", filterPlaceholder, f.applyModifiers(f.field))
+}
+
+func (f ArrayContainsFilter) Value() any {
+	return f.Filter.Value()
+}
+
+// FilterByEventType creates a new filter to match events by type.
+func FilterByEventType(eventType string) Filter {
+	return NewFilter(FieldEventType, eventType)
+}
+
+// FilterByEventTXs creates a new filter to match events by TX hashes.
+func FilterByEventTXs(hashes ...string) SliceFilter {
+	return NewStringSliceFilter(FieldEventTXHash, hashes)
+}
+
+// FilterByEventAttrName creates a new filter to match events by attribute name.
+func FilterByEventAttrName(name string) Filter {
+	return NewFilter(FieldEventAttrName, name)
+}
+
+// FilterByEventAttrValue creates a new filter to match events by attribute value.
+func FilterByEventAttrValue(v string) Filter {
+	// The string value must be quoted to match with the JSON text
+	v = strconv.Quote(v)
+
+	// Use a field modifier to cast the event attribute value JSON field to text
+	return NewFilter(FieldEventAttrValue, v, WithModifiers(CastJSONToText))
+}
+
+// FilterByEventAttrValueInt creates a new filter to match events by attribute value.
+func FilterByEventAttrValueInt(v int64) Filter {
+	// Use a field modifier to cast the event attribute value JSON field to numeric
+	return NewFilter(FieldEventAttrValue, v, WithModifiers(CastJSONToNumeric))
+}
+
+// FilterByBlockProposer creates a new filter to match blocks by proposer address.
+func FilterByBlockProposer(address string) Filter {
+	return NewFilter(FieldBlockProposer, address)
+}
+
+// FilterByMessageType creates a new filter to match messages by type URL.
+func FilterByMessageType(typeURL string) Filter {
+	return NewFilter(FieldMessageType, typeURL)
+}
+
+// FilterByMessageTXs creates a new filter to match messages by TX hashes.
+func FilterByMessageTXs(hashes ...string) SliceFilter {
+	return NewStringSliceFilter(FieldMessageTXHash, hashes)
+}
+
+// FilterByMessageSigner creates a new filter to match messages signed by an address.
+func FilterByMessageSigner(address string) ArrayContainsFilter {
+	return NewArrayContainsFilter(FieldMessageSigners, address)
+}
+
+// FilterByTXCode creates a new filter to match TXs by result code.
+func FilterByTXCode(code uint32) Filter {
+	return NewFilter(FieldTXCode, code)
+}
+
+// FilterByTXSuccess creates a new filter to match TXs that succeeded or failed.
+func FilterByTXSuccess(success bool) Filter {
+	// Successful TXs always have a zero result code
+	if success {
+		return FilterByTXCode(0)
+	}
+
+	return NewFilter(FieldTXCode, 0, WithOperator(OperatorNotEqual))
+}
+
+// FilterByTXMemo creates a new filter to match TXs by memo.
+func FilterByTXMemo(memo string) Filter {
+	return NewFilter(FieldTXMemo, memo)
+}
+
+// FilterByTXFeeDenom creates a new filter to match TXs that paid fees with a denom.
+func FilterByTXFeeDenom(denom string) ArrayContainsFilter {
+	// Use a field modifier to match the denoms of the fee coins
+	return NewArrayContainsFilter(FieldTXFee, denom, WithModifiers(ExtractFeeDenoms))
+}
+
+func encodeJSONArray[T any](values []T) string {
+	// Nil slices must be encoded as an empty array
+	if values == nil {
+		values = []T{}
+	}
+
+	// Encoding a slice of strings or integers can't fail
+	b, _ := json.Marshal(values)
+
+	return string(b)
+}
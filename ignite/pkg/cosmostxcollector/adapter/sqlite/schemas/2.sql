@@ -0,0 +1,31 @@
+CREATE TABLE block (
+    height      BIGINT NOT NULL,
+    hash        CHAR(64) NOT NULL,
+    proposer    VARCHAR NOT NULL,
+    "time"      TIMESTAMP NOT NULL,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT block_pk PRIMARY KEY (height)
+);
+
+ALTER TABLE tx ADD COLUMN gas_wanted BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE tx ADD COLUMN gas_used BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE tx ADD COLUMN fee TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE tx ADD COLUMN code INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE tx ADD COLUMN memo VARCHAR NOT NULL DEFAULT '';
+
+CREATE INDEX tx_code_idx ON tx (code);
+
+CREATE TABLE message (
+    tx_hash     CHAR(64) NOT NULL,
+    "index"     SMALLINT NOT NULL,
+    type_url    VARCHAR NOT NULL,
+    body        TEXT NOT NULL,
+    signers     TEXT NOT NULL,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT message_pk PRIMARY KEY (tx_hash, "index"),
+    CONSTRAINT message_tx_fk FOREIGN KEY (tx_hash) REFERENCES tx (hash) ON DELETE CASCADE
+);
+
+CREATE INDEX message_type_url_idx ON message (type_url);
@@ -0,0 +1,572 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	// Register the pure Go SQLite driver
+	_ "modernc.org/sqlite"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/postgres"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
+)
+
+const (
+	adapterType = "sqlite"
+
+	driverName = "sqlite"
+
+	sqlSelectBlockHeight = `
+		SELECT COALESCE(MAX(height), 0)
+		FROM tx
+	`
+	sqlSelectCheckpoints = `
+		SELECT height, hash FROM block
+		WHERE height <= ?
+		ORDER BY height DESC
+		LIMIT ?
+	`
+	sqlSelectEventAttrs = `
+		SELECT event_id, name, value FROM attribute
+		WHERE event_id IN (SELECT value FROM json_each(?))
+		ORDER BY event_id
+	`
+	sqlDeleteRawTXs = `
+		DELETE FROM raw_tx
+		WHERE hash IN (SELECT hash FROM tx WHERE height > ?)
+	`
+	sqlDeleteTXs = `
+		DELETE FROM tx
+		WHERE height > ?
+	`
+	sqlDeleteBlocks = `
+		DELETE FROM block
+		WHERE height > ?
+	`
+	sqlInsertBlock = `
+		INSERT INTO block (height, hash, proposer, "time")
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (height) DO NOTHING
+	`
+	sqlInsertTX = `
+		INSERT INTO tx (hash, "index", height, block_time, gas_wanted, gas_used, fee, code, memo)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	sqlInsertEvent = `
+		INSERT INTO event (tx_hash, "type", "index")
+		VALUES (?, ?, ?) RETURNING id
+	`
+	sqlInsertEventAttr = `
+		INSERT INTO attribute (event_id, name, value)
+		VALUES (?, ?, ?)
+	`
+	sqlInsertRawTX = `
+		INSERT INTO raw_tx (hash, data)
+		VALUES (?, ?)
+	`
+	sqlInsertMessage = `
+		INSERT INTO message (tx_hash, "index", type_url, body, signers)
+		VALUES (?, ?, ?, ?, ?)
+	`
+)
+
+//go:embed schemas/*
+var fsSchemas embed.FS
+
+// ErrClosed is returned when database connection is not open.
+var ErrClosed = errors.New("no database connection")
+
+// Option defines an option for the adapter.
+type Option func(*Adapter)
+
+// WithParams configures extra database parameters.
+// Parameters are passed to the SQLite driver, for example "_pragma": "journal_mode(WAL)".
+func WithParams(params map[string]string) Option {
+	return func(a *Adapter) {
+		a.params = params
+	}
+}
+
+// NewAdapter creates a new SQLite adapter.
+// The database file is created when it doesn't exist.
+func NewAdapter(path string, options ...Option) (Adapter, error) {
+	adapter := Adapter{
+		path:    path,
+		schemas: postgres.NewSchemas(fsSchemas, ""),
+	}
+
+	for _, o := range options {
+		o(&adapter)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return Adapter{}, err
+	}
+
+	db, err := sql.Open(driverName, createSQLiteURI(adapter))
+	if err != nil {
+		return Adapter{}, err
+	}
+
+	// SQLite allows a single writer so a single connection is used to
+	// avoid "database is locked" errors when saving concurrently.
+	db.SetMaxOpenConns(1)
+
+	adapter.db = db
+
+	return adapter, nil
+}
+
+// Adapter implements a data backend adapter for SQLite.
+type Adapter struct {
+	path    string
+	params  map[string]string
+	db      *sql.DB
+	schemas postgres.Schemas
+}
+
+// UpdateSchema updates the database schema to the latest version available.
+// It applies all available schemas that were not applied already.
+func (a Adapter) UpdateSchema(ctx context.Context, s postgres.Schemas) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	// Create the schema table if it doesn't exists
+	if _, err := db.ExecContext(ctx, s.GetTableDDL()); err != nil {
+		return fmt.Errorf("failed to check schema table: %w", err)
+	}
+
+	// Get the current schema version
+	var v uint64
+	if err := db.QueryRowContext(ctx, s.GetSchemaVersionSQL()).Scan(&v); err != nil {
+		return fmt.Errorf("failed to read current schema version: %w", err)
+	}
+
+	return s.WalkFrom(v+1, func(version uint64, script []byte) error {
+		if _, err := db.ExecContext(ctx, string(script)); err != nil {
+			return fmt.Errorf("error applying schema version %d: %w", version, err)
+		}
+
+		return nil
+	})
+}
+
+func (a Adapter) GetType() string {
+	return adapterType
+}
+
+func (a Adapter) Init(ctx context.Context) error {
+	return a.UpdateSchema(ctx, a.schemas)
+}
+
+// Close closes the database.
+func (a Adapter) Close() error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	return db.Close()
+}
+
+func (a Adapter) Save(ctx context.Context, txs []cosmosclient.TX) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	// Start a transaction
+	sqlTx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// Rollback won't have any effect if the transaction is committed before
+	defer sqlTx.Rollback()
+
+	// Prepare insert statements to speed up "bulk" saving times
+	txStmt, err := sqlTx.PrepareContext(ctx, sqlInsertTX)
+	if err != nil {
+		return err
+	}
+
+	defer txStmt.Close()
+
+	evtStmt, err := sqlTx.PrepareContext(ctx, sqlInsertEvent)
+	if err != nil {
+		return err
+	}
+
+	defer evtStmt.Close()
+
+	attrStmt, err := sqlTx.PrepareContext(ctx, sqlInsertEventAttr)
+	if err != nil {
+		return err
+	}
+
+	defer attrStmt.Close()
+
+	msgStmt, err := sqlTx.PrepareContext(ctx, sqlInsertMessage)
+	if err != nil {
+		return err
+	}
+
+	defer msgStmt.Close()
+
+	// All the transactions are saved within the context of the same database
+	// transactions and because of that either all block transactions are
+	// saved or none of them.
+	var height int64
+	for _, tx := range txs {
+		// Save the block once for all its transactions
+		if tx.Raw.Height != height {
+			if err := saveBlock(ctx, sqlTx, tx); err != nil {
+				return err
+			}
+
+			height = tx.Raw.Height
+		}
+
+		if err := saveRawTX(ctx, sqlTx, tx.Raw); err != nil {
+			return err
+		}
+
+		if err := saveTX(ctx, txStmt, evtStmt, attrStmt, tx); err != nil {
+			return err
+		}
+
+		if err := saveMessages(ctx, msgStmt, tx); err != nil {
+			return err
+		}
+	}
+
+	return sqlTx.Commit()
+}
+
+func (a Adapter) GetLatestHeight(ctx context.Context) (height int64, err error) {
+	db, err := a.getDB()
+	if err != nil {
+		return 0, err
+	}
+
+	row := db.QueryRowContext(ctx, sqlSelectBlockHeight)
+	if err = row.Scan(&height); err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
+
+func (a Adapter) GetCheckpoints(ctx context.Context, toHeight int64, limit int) ([]adapter.Checkpoint, error) {
+	db, err := a.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, sqlSelectCheckpoints, toHeight, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var checkpoints []adapter.Checkpoint
+	for rows.Next() {
+		var cp adapter.Checkpoint
+		if err := rows.Scan(&cp.Height, &cp.Hash); err != nil {
+			return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+		}
+
+		checkpoints = append(checkpoints, cp)
+	}
+
+	return checkpoints, rows.Err()
+}
+
+func (a Adapter) Rollback(ctx context.Context, height int64) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	sqlTx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// Rollback won't have any effect if the transaction is committed before
+	defer sqlTx.Rollback()
+
+	// Events, attributes and messages are deleted in cascade with the TXs
+	for _, sql := range []string{sqlDeleteRawTXs, sqlDeleteTXs, sqlDeleteBlocks} {
+		if _, err := sqlTx.ExecContext(ctx, sql, height); err != nil {
+			return fmt.Errorf("error rolling back to block %d: %w", height, err)
+		}
+	}
+
+	return sqlTx.Commit()
+}
+
+func (a Adapter) QueryEvents(ctx context.Context, q query.EventQuery) ([]query.Event, error) {
+	db, err := a.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	sql := parseEventQuery(q)
+	args := extractEventQueryArgs(q)
+	rows, err := db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var (
+		events   []query.Event
+		eventIDs []int64
+
+		// Keep an index of the event position within the events slice
+		// to find them later when updating their attributes.
+		eventIndexes = make(map[int64]int)
+	)
+
+	for i := 0; rows.Next(); i++ {
+		e := query.Event{}
+		if err := rows.Scan(&e.ID, &e.Index, &e.TXHash, &e.Type, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read event: %w", err)
+		}
+
+		events = append(events, e)
+		eventIDs = append(eventIDs, e.ID)
+
+		eventIndexes[e.ID] = i
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Don't query attributes when there are no events
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	ids, err := json.Marshal(eventIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Select the attributes for the events that matched the query
+	attrRows, err := db.QueryContext(ctx, sqlSelectEventAttrs, string(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	defer attrRows.Close()
+
+	// Update the attributes of the selected events
+	for attrRows.Next() {
+		var (
+			eventID int64
+			name    string
+			value   []byte
+		)
+
+		if err := attrRows.Scan(&eventID, &name, &value); err != nil {
+			return nil, fmt.Errorf("failed to read event attribute: %w", err)
+		}
+
+		i := eventIndexes[eventID]
+		events[i].Attributes = append(events[i].Attributes, query.NewAttribute(name, value))
+	}
+
+	return events, attrRows.Err()
+}
+
+func (a Adapter) Query(ctx context.Context, q query.Query) (query.Cursor, error) {
+	db, err := a.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	sql, err := parseQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	args := extractQueryArgs(q)
+	rows, err := db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (a Adapter) getDB() (*sql.DB, error) {
+	if a.db == nil {
+		return nil, ErrClosed
+	}
+
+	return a.db, nil
+}
+
+func createSQLiteURI(a Adapter) string {
+	// Foreign keys are disabled by default in SQLite
+	query := url.Values{}
+	query.Add("_pragma", "foreign_keys(1)")
+
+	// Add extra params as query arguments
+	for k, v := range a.params {
+		query.Add(k, v)
+	}
+
+	uri := url.URL{
+		Scheme:   "file",
+		Opaque:   a.path,
+		RawQuery: query.Encode(),
+	}
+
+	return uri.String()
+}
+
+func saveBlock(ctx context.Context, sqlTx *sql.Tx, tx cosmosclient.TX) error {
+	height := tx.Raw.Height
+	if _, err := sqlTx.ExecContext(ctx, sqlInsertBlock, height, tx.BlockHash, tx.BlockProposer, tx.BlockTime); err != nil {
+		return fmt.Errorf("error saving block %d: %w", height, err)
+	}
+
+	return nil
+}
+
+func saveRawTX(ctx context.Context, sqlTx *sql.Tx, rtx *ctypes.ResultTx) error {
+	hash := rtx.Hash.String()
+	raw, err := json.Marshal(rtx)
+	if err != nil {
+		return fmt.Errorf("failed to encode raw TX %s: %w", hash, err)
+	}
+
+	if _, err := sqlTx.ExecContext(ctx, sqlInsertRawTX, hash, string(raw)); err != nil {
+		return fmt.Errorf("error saving raw TX %s: %w", hash, err)
+	}
+
+	return nil
+}
+
+func saveTX(ctx context.Context, txStmt, evtStmt, attrStmt *sql.Stmt, tx cosmosclient.TX) error {
+	hash := tx.Raw.Hash.String()
+	fee, err := encodeFee(tx.Fee)
+	if err != nil {
+		return fmt.Errorf("failed to encode TX %s fee: %w", hash, err)
+	}
+
+	res := tx.Raw.TxResult
+	if _, err := txStmt.ExecContext(
+		ctx,
+		hash,
+		tx.Raw.Index,
+		tx.Raw.Height,
+		tx.BlockTime,
+		res.GasWanted,
+		res.GasUsed,
+		fee,
+		res.Code,
+		tx.Memo,
+	); err != nil {
+		return fmt.Errorf("error saving TX %s: %w", hash, err)
+	}
+
+	events, err := tx.GetEvents()
+	if err != nil {
+		return err
+	}
+
+	for i, evt := range events {
+		var evtID int64
+
+		row := evtStmt.QueryRowContext(ctx, hash, evt.Type, i)
+		if err := row.Err(); err != nil {
+			return fmt.Errorf("error saving event '%s': %w", evt.Type, err)
+		}
+
+		if err := row.Scan(&evtID); err != nil {
+			return fmt.Errorf("error reading event ID: %w", err)
+		}
+
+		for _, attr := range evt.Attributes {
+			// Values are saved as text to be able to use the SQLite JSON functions
+			if _, err := attrStmt.ExecContext(ctx, evtID, attr.Key, string(attr.Value)); err != nil {
+				return fmt.Errorf("error saving event attr '%s.%s': %w", evt.Type, attr.Key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func saveMessages(ctx context.Context, msgStmt *sql.Stmt, tx cosmosclient.TX) error {
+	hash := tx.Raw.Hash.String()
+	for i, msg := range tx.Messages {
+		// SQLite doesn't support arrays so signers are saved as a JSON array
+		signers := encodeJSONArray(msg.Signers)
+		if _, err := msgStmt.ExecContext(ctx, hash, i, msg.TypeURL, string(msg.Body), signers); err != nil {
+			return fmt.Errorf("error saving message '%s': %w", msg.TypeURL, err)
+		}
+	}
+
+	return nil
+}
+
+func encodeFee(fee sdktypes.Coins) (string, error) {
+	// TXs without fees are saved with an empty list of coins
+	if fee == nil {
+		fee = sdktypes.Coins{}
+	}
+
+	b, err := json.Marshal(fee)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func extractQueryArgs(q query.Query) []any {
+	// When the query is a call to a table-valued function
+	// add the arguments before the filter values
+	args := q.Args()
+
+	// Add the values from the filters
+	for _, f := range q.Filters() {
+		if a := f.Value(); a != nil {
+			args = append(args, a)
+		}
+	}
+
+	return args
+}
+
+func extractEventQueryArgs(q query.EventQuery) (args []any) {
+	for _, f := range q.Filters() {
+		if a := f.Value(); a != nil {
+			args = append(args, a)
+		}
+	}
+
+	return args
+}
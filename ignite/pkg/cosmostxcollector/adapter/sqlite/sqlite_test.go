@@ -0,0 +1,267 @@
+package sqlite_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/sqlite"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/query"
+)
+
+func TestAdapter(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+
+	adapter, err := sqlite.NewAdapter(filepath.Join(t.TempDir(), "txs.db"))
+	require.NoError(t, err)
+
+	defer adapter.Close()
+
+	// Arrange: Init is called twice to check that applied schemas are skipped
+	require.NoError(t, adapter.Init(ctx))
+	require.NoError(t, adapter.Init(ctx))
+
+	// Act
+	err = adapter.Save(ctx, []cosmosclient.TX{
+		createTX(1, 0, "transfer", "recipient", "cosmos1a"),
+		createTX(2, 0, "transfer", "recipient", "cosmos1b"),
+		createTX(2, 1, "message", "sender", "cosmos1a"),
+	})
+
+	// Assert
+	require.NoError(t, err)
+
+	height, err := adapter.GetLatestHeight(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, height)
+
+	// Assert: events are filtered by type and attribute value
+	events, err := adapter.QueryEvents(ctx, query.NewEventQuery(
+		query.WithFilters(
+			sqlite.FilterByEventType("transfer"),
+			sqlite.FilterByEventAttrName("recipient"),
+			sqlite.FilterByEventAttrValue("cosmos1b"),
+		),
+	))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "transfer", events[0].Type)
+	require.Len(t, events[0].Attributes, 1)
+
+	v, err := events[0].Attributes[0].Value()
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1b", v)
+
+	// Assert: events are filtered by TX hash
+	hash := createTX(1, 0, "", "", "").Raw.Hash.String()
+	events, err = adapter.QueryEvents(ctx, query.NewEventQuery(
+		query.WithFilters(sqlite.FilterByEventTXs(hash)),
+	))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, hash, events[0].TXHash)
+
+	// Assert: events are paginated
+	events, err = adapter.QueryEvents(ctx, query.NewEventQuery(query.WithPageSize(2), query.AtPage(2)))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "message", events[0].Type)
+
+	// Assert: generic queries support sorting and filters
+	cr, err := adapter.Query(ctx, query.New(
+		"tx",
+		query.Fields("height", `"index"`),
+		query.SortByFields(query.SortOrderDesc, "height", `"index"`),
+		query.WithFilters(sqlite.NewFilter("height", 2)),
+	))
+	require.NoError(t, err)
+
+	var rows [][2]int64
+	for cr.Next() {
+		var row [2]int64
+		require.NoError(t, cr.Scan(&row[0], &row[1]))
+		rows = append(rows, row)
+	}
+
+	require.NoError(t, cr.Err())
+	require.Equal(t, [][2]int64{{2, 1}, {2, 0}}, rows)
+}
+
+func createTX(height, index int64, eventType, attrName, attrValue string) cosmosclient.TX {
+	hash := sha256.Sum256([]byte{byte(height), byte(index)})
+
+	var events []abci.Event
+	if eventType != "" {
+		events = append(events, abci.Event{
+			Type: eventType,
+			Attributes: []abci.EventAttribute{
+				{Key: []byte(attrName), Value: []byte(attrValue)},
+			},
+		})
+	}
+
+	return cosmosclient.TX{
+		BlockTime: time.Unix(height, 0).UTC(),
+		Raw: &ctypes.ResultTx{
+			Hash:   hash[:],
+			Height: height,
+			Index:  uint32(index),
+			TxResult: abci.ResponseDeliverTx{
+				Events: events,
+			},
+		},
+	}
+}
+
+func TestAdapterMessages(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+
+	adapter, err := sqlite.NewAdapter(filepath.Join(t.TempDir(), "txs.db"))
+	require.NoError(t, err)
+
+	defer adapter.Close()
+
+	require.NoError(t, adapter.Init(ctx))
+
+	// Act
+	err = adapter.Save(ctx, []cosmosclient.TX{
+		createMessageTX(1, 0, 0, "/cosmos.bank.v1beta1.MsgSend", "cosmos1a", "token"),
+		createMessageTX(1, 1, 5, "/cosmos.bank.v1beta1.MsgSend", "cosmos1b", "stake"),
+		createMessageTX(1, 2, 0, "/cosmos.staking.v1beta1.MsgDelegate", "cosmos1a", "stake"),
+	})
+
+	// Assert
+	require.NoError(t, err)
+
+	// Assert: messages are filtered by type and signer
+	hashes := queryStrings(t, adapter, query.New(
+		"message",
+		query.Fields("tx_hash"),
+		query.WithFilters(
+			sqlite.FilterByMessageType("/cosmos.bank.v1beta1.MsgSend"),
+			sqlite.FilterByMessageSigner("cosmos1a"),
+		),
+	))
+	require.Equal(t, []string{createTX(1, 0, "", "", "").Raw.Hash.String()}, hashes)
+
+	// Assert: TXs are filtered by result code and fee denom
+	memos := queryStrings(t, adapter, query.New(
+		"tx",
+		query.Fields("memo"),
+		query.WithFilters(
+			sqlite.FilterByTXSuccess(true),
+			sqlite.FilterByTXFeeDenom("stake"),
+		),
+	))
+	require.Equal(t, []string{"memo 2"}, memos)
+
+	memos = queryStrings(t, adapter, query.New(
+		"tx",
+		query.Fields("memo"),
+		query.WithFilters(sqlite.FilterByTXSuccess(false)),
+	))
+	require.Equal(t, []string{"memo 1"}, memos)
+
+	// Assert: blocks are saved once
+	hashes = queryStrings(t, adapter, query.New(
+		"block",
+		query.Fields("hash"),
+		query.WithFilters(sqlite.FilterByBlockProposer("PROPOSER")),
+	))
+	require.Equal(t, []string{"BLOCK1"}, hashes)
+}
+
+func TestAdapterRollback(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+
+	db, err := sqlite.NewAdapter(filepath.Join(t.TempDir(), "txs.db"))
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	require.NoError(t, db.Init(ctx))
+
+	for height := int64(1); height <= 3; height++ {
+		tx := createMessageTX(height, 0, 0, "/cosmos.bank.v1beta1.MsgSend", "cosmos1a", "token")
+		tx.Raw.TxResult.Events = createTX(height, 0, "transfer", "recipient", "cosmos1a").Raw.TxResult.Events
+		require.NoError(t, db.Save(ctx, []cosmosclient.TX{tx}))
+	}
+
+	checkpoints, err := db.GetCheckpoints(ctx, 2, 5)
+	require.NoError(t, err)
+	require.Equal(t, []adapter.Checkpoint{
+		{Height: 2, Hash: "BLOCK2"},
+		{Height: 1, Hash: "BLOCK1"},
+	}, checkpoints)
+
+	// Act
+	err = db.Rollback(ctx, 1)
+
+	// Assert
+	require.NoError(t, err)
+
+	height, err := db.GetLatestHeight(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, height)
+
+	checkpoints, err = db.GetCheckpoints(ctx, 10, 5)
+	require.NoError(t, err)
+	require.Equal(t, []adapter.Checkpoint{{Height: 1, Hash: "BLOCK1"}}, checkpoints)
+
+	hash := createTX(1, 0, "", "", "").Raw.Hash.String()
+	hashes := queryStrings(t, db, query.New("message", query.Fields("tx_hash")))
+	require.Equal(t, []string{hash}, hashes)
+
+	events, err := db.QueryEvents(ctx, query.NewEventQuery())
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, hash, events[0].TXHash)
+}
+
+func createMessageTX(height, index int64, code uint32, typeURL, signer, feeDenom string) cosmosclient.TX {
+	tx := createTX(height, index, "", "", "")
+	tx.BlockHash = fmt.Sprintf("BLOCK%d", height)
+	tx.BlockProposer = "PROPOSER"
+	tx.Memo = fmt.Sprintf("memo %d", index)
+	tx.Fee = sdktypes.NewCoins(sdktypes.NewInt64Coin(feeDenom, 10))
+	tx.Messages = []cosmosclient.TXMessage{
+		{
+			TypeURL: typeURL,
+			Body:    []byte(`{}`),
+			Signers: []string{signer},
+		},
+	}
+	tx.Raw.TxResult.Code = code
+
+	return tx
+}
+
+func queryStrings(t *testing.T, adapter sqlite.Adapter, q query.Query) (values []string) {
+	t.Helper()
+
+	cr, err := adapter.Query(context.Background(), q)
+	require.NoError(t, err)
+
+	for cr.Next() {
+		var v string
+		require.NoError(t, cr.Scan(&v))
+		values = append(values, v)
+	}
+
+	require.NoError(t, cr.Err())
+
+	return values
+}
@@ -16,20 +16,54 @@ type TXsCollecter interface {
 	CollectTXs(ctx context.Context, fromHeight int64, tc chan<- []cosmosclient.TX) error
 }
 
+// Option configures the collector.
+type Option func(*Collector)
+
+// WithReconciler sets a reconciler to remove the saved blocks that no longer exist
+// in the chain before starting the collection.
+func WithReconciler(r Reconciler) Option {
+	return func(c *Collector) {
+		c.reconciler = &r
+	}
+}
+
 // New creates a new Cosmos transaction collector.
-func New(db adapter.Saver, client TXsCollecter) Collector {
-	return Collector{db, client}
+func New(db adapter.Saver, client TXsCollecter, options ...Option) Collector {
+	c := Collector{
+		db:     db,
+		client: client,
+	}
+
+	for _, apply := range options {
+		apply(&c)
+	}
+
+	return c
 }
 
 // Collector defines a type to collect and save Cosmos transactions in a data backend.
 type Collector struct {
-	db     adapter.Saver
-	client TXsCollecter
+	db         adapter.Saver
+	client     TXsCollecter
+	reconciler *Reconciler
 }
 
 // Collect gathers transactions for all blocks starting from a specific height.
 // Each group of block transactions is saved sequentially after being collected.
+// When a reconciler is set the collection starts after the latest saved block
+// that still exists in the chain if it is lower than the starting height.
 func (c Collector) Collect(ctx context.Context, fromHeight int64) error {
+	if c.reconciler != nil {
+		height, rolledBack, err := c.reconciler.Reconcile(ctx)
+		if err != nil {
+			return err
+		}
+
+		if rolledBack && height < fromHeight {
+			fromHeight = height + 1
+		}
+	}
+
 	tc := make(chan []cosmosclient.TX)
 	wg, ctx := errgroup.WithContext(ctx)
 
@@ -0,0 +1,342 @@
+package cosmostxcollector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ignite/cli/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+const (
+	// DefaultRetryDelay is the default time to wait before retrying after a node error.
+	DefaultRetryDelay = time.Second
+
+	// DefaultMaxRetryDelay is the default max. time to wait before retrying after a node error.
+	DefaultMaxRetryDelay = time.Minute
+
+	// DefaultCheckpointInterval is the default time between checks of the saved blocks
+	// to detect the ones that no longer exist in the chain.
+	DefaultCheckpointInterval = time.Minute
+)
+
+// TXsFollower defines the interface for Cosmos clients that support collection
+// of transactions and waiting for new blocks.
+type TXsFollower interface {
+	TXsCollecter
+	BlockHashFetcher
+
+	// WaitForBlockHeight waits until a block height is committed.
+	WaitForBlockHeight(ctx context.Context, h int64) error
+}
+
+// FollowerOption configures the follower.
+type FollowerOption func(*Follower)
+
+// FromHeight sets the height to start collecting from when the data backend is empty.
+func FromHeight(height int64) FollowerOption {
+	return func(f *Follower) {
+		f.fromHeight = height
+	}
+}
+
+// RetryDelay sets the initial and the max. time to wait before retrying after a node error.
+// The delay is doubled after each consecutive error until it reaches the max. delay.
+func RetryDelay(delay, maxDelay time.Duration) FollowerOption {
+	return func(f *Follower) {
+		f.retryDelay = delay
+		f.maxRetryDelay = maxDelay
+	}
+}
+
+// CheckpointInterval sets the time between checks of the saved blocks.
+// The hashes of the saved blocks are compared with the ones in the chain when
+// the follower starts and then periodically to roll back the blocks that no
+// longer exist in the chain, for example after the node is reset.
+func CheckpointInterval(d time.Duration) FollowerOption {
+	return func(f *Follower) {
+		f.checkpointInterval = d
+	}
+}
+
+// CollectEvents sets the event bus used to report the collection progress.
+func CollectEvents(ev events.Bus) FollowerOption {
+	return func(f *Follower) {
+		f.ev = ev
+	}
+}
+
+// NewFollower creates a new Cosmos transaction follower.
+func NewFollower(db adapter.Adapter, client TXsFollower, options ...FollowerOption) Follower {
+	f := Follower{
+		db:                 db,
+		client:             client,
+		fromHeight:         1,
+		retryDelay:         DefaultRetryDelay,
+		maxRetryDelay:      DefaultMaxRetryDelay,
+		checkpointInterval: DefaultCheckpointInterval,
+	}
+
+	for _, apply := range options {
+		apply(&f)
+	}
+
+	return f
+}
+
+// Follower defines a type to continuously collect and save Cosmos transactions in a data backend.
+type Follower struct {
+	db                 adapter.Adapter
+	client             TXsFollower
+	fromHeight         int64
+	retryDelay         time.Duration
+	maxRetryDelay      time.Duration
+	checkpointInterval time.Duration
+	ev                 events.Bus
+}
+
+// Follow collects the transactions starting from the block after the latest one
+// saved in the data backend and keeps collecting them for each new block until
+// the context is canceled.
+// Saved blocks that no longer exist in the chain are rolled back before resuming.
+// Node errors are retried so the collection continues after reconnecting to the node.
+// Data backend errors stop the collection.
+func (f Follower) Follow(ctx context.Context) error {
+	if err := f.db.Init(ctx); err != nil {
+		return err
+	}
+
+	savedHeight, err := f.db.GetLatestHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	next := f.fromHeight
+	if savedHeight >= next {
+		next = savedHeight + 1
+	}
+
+	f.ev.Send(fmt.Sprintf("Collecting transactions from block %d", next), events.ProgressStart())
+
+	var (
+		saver      = newHeightSaver(f.db, f.ev, next)
+		collector  = New(saver, f.client)
+		reconciler = NewReconciler(f.db, f.client)
+		delay      = f.retryDelay
+		checkedAt  time.Time
+	)
+
+	for {
+		err := f.reconcile(ctx, reconciler, saver, &next, &checkedAt)
+		if err == nil {
+			err = f.collect(ctx, collector, saver, &next)
+		}
+
+		if ctx.Err() != nil {
+			// The saved blocks are complete because transactions are saved per block
+			f.ev.Send(
+				fmt.Sprintf("Stopped collecting transactions, next block is %d", next),
+				events.ProgressFinish(),
+			)
+
+			return nil
+		}
+
+		var backendErr backendError
+		if errors.As(err, &backendErr) {
+			return backendErr.err
+		}
+
+		if err == nil {
+			delay = f.retryDelay
+			continue
+		}
+
+		// Retry after a node error, for example when the connection is lost
+		f.ev.Send(
+			fmt.Sprintf("Node error: %s, retrying in %s", err, delay),
+			events.Icon(icons.NotOK),
+			events.ProgressUpdate(),
+		)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+		}
+
+		if delay *= 2; delay > f.maxRetryDelay {
+			delay = f.maxRetryDelay
+		}
+	}
+}
+
+// reconcile rolls back the saved blocks that no longer exist in the chain when
+// the time since the last check is greater than the checkpoint interval.
+// The next block height to collect is updated when the saved blocks are rolled back.
+func (f Follower) reconcile(
+	ctx context.Context,
+	r Reconciler,
+	saver *heightSaver,
+	next *int64,
+	checkedAt *time.Time,
+) error {
+	if time.Since(*checkedAt) < f.checkpointInterval {
+		return nil
+	}
+
+	height, rolledBack, err := r.Reconcile(ctx)
+	if err != nil {
+		return err
+	}
+
+	*checkedAt = time.Now()
+
+	if !rolledBack {
+		return nil
+	}
+
+	saver.rollback(height)
+
+	if *next = height + 1; *next < f.fromHeight {
+		*next = f.fromHeight
+	}
+
+	f.ev.Send(
+		fmt.Sprintf("Blocks above %d no longer exist in the chain, collecting transactions from block %d", height, *next),
+		events.Icon(icons.Info),
+		events.ProgressUpdate(),
+	)
+
+	return nil
+}
+
+// collect collects the transactions up to the latest block and waits for the next one.
+// The next block height to collect is updated even when the collection fails.
+func (f Follower) collect(ctx context.Context, collector Collector, saver *heightSaver, next *int64) error {
+	latestHeight, err := f.client.LatestBlockHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	if *next <= latestHeight {
+		err := collector.Collect(ctx, *next)
+
+		// Blocks up to the latest saved height are complete
+		if h := saver.height(); h >= *next {
+			*next = h + 1
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Blocks without transactions up to the latest height are not saved
+		if latestHeight >= *next {
+			*next = latestHeight + 1
+		}
+	}
+
+	f.ev.Send(fmt.Sprintf("Waiting for block %d", *next), events.ProgressUpdate())
+
+	// Stop waiting when the saved blocks must be checked because after
+	// a node reset the next block might not be created for a long time.
+	waitCtx, cancel := context.WithTimeout(ctx, f.checkpointInterval)
+	defer cancel()
+
+	err = f.client.WaitForBlockHeight(waitCtx, *next)
+	if errors.Is(waitCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		return nil
+	}
+
+	return err
+}
+
+// backendError wraps the errors returned by the data backend.
+type backendError struct {
+	err error
+}
+
+func (e backendError) Error() string {
+	return e.err.Error()
+}
+
+func newHeightSaver(db adapter.Saver, ev events.Bus, fromHeight int64) *heightSaver {
+	return &heightSaver{
+		Saver:      db,
+		ev:         ev,
+		fromHeight: fromHeight,
+		startedAt:  time.Now(),
+	}
+}
+
+// heightSaver keeps track of the latest block height saved into the data backend.
+// It also reports the collection throughput in blocks per second.
+type heightSaver struct {
+	adapter.Saver
+
+	ev           events.Bus
+	fromHeight   int64
+	startedAt    time.Time
+	mu           sync.Mutex
+	latestHeight int64
+}
+
+func (s *heightSaver) Save(ctx context.Context, txs []cosmosclient.TX) error {
+	if err := s.Saver.Save(ctx, txs); err != nil {
+		// Errors caused by a canceled context are not data backend errors
+		if ctx.Err() != nil {
+			return err
+		}
+
+		return backendError{err}
+	}
+
+	if len(txs) == 0 {
+		return nil
+	}
+
+	height := txs[0].Raw.Height
+
+	s.mu.Lock()
+	s.latestHeight = height
+	s.mu.Unlock()
+
+	s.ev.Send(
+		fmt.Sprintf("Collected %d transaction(s) from block %d (%.1f blocks/s)", len(txs), height, s.throughput(height)),
+		events.ProgressUpdate(),
+	)
+
+	return nil
+}
+
+// rollback sets the latest saved height after the saved blocks are rolled back.
+func (s *heightSaver) rollback(height int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.latestHeight > height {
+		s.latestHeight = height
+	}
+}
+
+func (s *heightSaver) height() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.latestHeight
+}
+
+// throughput returns the number of blocks collected per second up to a height.
+func (s *heightSaver) throughput(height int64) float64 {
+	elapsed := time.Since(s.startedAt).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return float64(height-s.fromHeight+1) / elapsed
+}
@@ -0,0 +1,238 @@
+package cosmostxcollector_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter/boltdb"
+)
+
+// chain is a fake client that produces a new block each time the follower waits for it.
+type chain struct {
+	latestHeight int64
+	maxHeight    int64
+	failures     int
+	cancel       context.CancelFunc
+
+	// Blocks above the fork height have different hashes after the chain is forked
+	forked     bool
+	forkHeight int64
+}
+
+// fork simulates a chain where the blocks above a height are replaced by new ones.
+func (c *chain) fork(height int64) {
+	c.forked = true
+	c.forkHeight = height
+}
+
+func (c *chain) blockHash(height int64) string {
+	if c.forked && height > c.forkHeight {
+		return fmt.Sprintf("fork-%d", height)
+	}
+
+	return fmt.Sprintf("block-%d", height)
+}
+
+func (c *chain) GetBlockHash(_ context.Context, height int64) (string, error) {
+	return c.blockHash(height), nil
+}
+
+func (c *chain) LatestBlockHeight(context.Context) (int64, error) {
+	// Simulate a lost connection to the node
+	if c.failures > 0 {
+		c.failures--
+		return 0, errors.New("connection refused")
+	}
+
+	return c.latestHeight, nil
+}
+
+func (c *chain) WaitForBlockHeight(ctx context.Context, h int64) error {
+	if h > c.maxHeight {
+		c.cancel()
+		return ctx.Err()
+	}
+
+	if h > c.latestHeight {
+		c.latestHeight = h
+	}
+
+	return nil
+}
+
+func (c *chain) CollectTXs(ctx context.Context, fromHeight int64, tc chan<- []cosmosclient.TX) error {
+	defer close(tc)
+
+	for height := fromHeight; height <= c.latestHeight; height++ {
+		// Odd blocks don't have transactions
+		if height%2 != 0 {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case tc <- []cosmosclient.TX{createTX(height, c.blockHash(height))}:
+		}
+	}
+
+	return nil
+}
+
+func TestFollower(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, err := boltdb.NewAdapter(filepath.Join(t.TempDir(), "txs.db"))
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	client := &chain{
+		latestHeight: 3,
+		maxHeight:    6,
+		failures:     1,
+		cancel:       cancel,
+	}
+
+	f := cosmostxcollector.NewFollower(db, client, cosmostxcollector.RetryDelay(time.Millisecond, time.Millisecond))
+
+	// Act
+	err = f.Follow(ctx)
+
+	// Assert
+	require.NoError(t, err)
+
+	height, err := db.GetLatestHeight(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 6, height)
+
+	// Act: resume the collection from the latest saved height
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	client.maxHeight = 8
+	client.cancel = cancel
+
+	err = f.Follow(ctx)
+
+	// Assert
+	require.NoError(t, err)
+
+	height, err = db.GetLatestHeight(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 8, height)
+}
+
+func TestFollowerWithChainReset(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	db, err := boltdb.NewAdapter(filepath.Join(t.TempDir(), "txs.db"))
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	client := &chain{
+		latestHeight: 1,
+		maxHeight:    6,
+		cancel:       cancel,
+	}
+
+	f := cosmostxcollector.NewFollower(db, client)
+
+	err = f.Follow(ctx)
+	require.NoError(t, err)
+
+	// Act: reset the chain so it has less blocks than the ones that were collected
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	client.fork(0)
+	client.latestHeight = 2
+	client.maxHeight = 4
+	client.cancel = cancel
+
+	err = f.Follow(ctx)
+
+	// Assert
+	require.NoError(t, err)
+
+	height, err := db.GetLatestHeight(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 4, height)
+
+	checkpoints, err := db.GetCheckpoints(context.Background(), 10, 10)
+	require.NoError(t, err)
+	require.Equal(t, []adapter.Checkpoint{
+		{Height: 4, Hash: "fork-4"},
+		{Height: 2, Hash: "fork-2"},
+	}, checkpoints)
+}
+
+func TestReconciler(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+
+	db, err := boltdb.NewAdapter(filepath.Join(t.TempDir(), "txs.db"))
+	require.NoError(t, err)
+
+	defer db.Close()
+
+	require.NoError(t, db.Init(ctx))
+
+	client := &chain{latestHeight: 8}
+	for height := int64(2); height <= 8; height += 2 {
+		require.NoError(t, db.Save(ctx, []cosmosclient.TX{createTX(height, client.blockHash(height))}))
+	}
+
+	r := cosmostxcollector.NewReconciler(db, client)
+
+	// Act
+	height, rolledBack, err := r.Reconcile(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	require.False(t, rolledBack)
+	require.EqualValues(t, 8, height)
+
+	// Act: replace the blocks above the height 5
+	client.fork(5)
+
+	height, rolledBack, err = r.Reconcile(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	require.True(t, rolledBack)
+	require.EqualValues(t, 4, height)
+
+	height, err = db.GetLatestHeight(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, height)
+}
+
+func createTX(height int64, blockHash string) cosmosclient.TX {
+	hash := sha256.Sum256([]byte{byte(height)})
+
+	return cosmosclient.TX{
+		BlockTime: time.Unix(height, 0).UTC(),
+		BlockHash: blockHash,
+		Raw: &ctypes.ResultTx{
+			Hash:   hash[:],
+			Height: height,
+		},
+	}
+}
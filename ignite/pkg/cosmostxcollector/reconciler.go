@@ -0,0 +1,103 @@
+package cosmostxcollector
+
+import (
+	"context"
+	"math"
+
+	"github.com/ignite/cli/ignite/pkg/cosmostxcollector/adapter"
+)
+
+// checkpointsPageSize defines the number of saved blocks compared at once with the chain.
+const checkpointsPageSize = 100
+
+// BlockHashFetcher defines the interface for Cosmos clients that support fetching block hashes.
+type BlockHashFetcher interface {
+	// LatestBlockHeight returns the height of the latest block.
+	LatestBlockHeight(ctx context.Context) (int64, error)
+
+	// GetBlockHash returns the hash of a block.
+	GetBlockHash(ctx context.Context, height int64) (string, error)
+}
+
+// NewReconciler creates a new reconciler for the blocks saved in a data backend.
+func NewReconciler(db adapter.Checkpointer, client BlockHashFetcher) Reconciler {
+	return Reconciler{db, client}
+}
+
+// Reconciler defines a type to remove the saved blocks that no longer exist in a chain.
+// Blocks can disappear from a chain when the node is reset, in which case new blocks
+// with different hashes can be created for heights that were already collected.
+type Reconciler struct {
+	db     adapter.Checkpointer
+	client BlockHashFetcher
+}
+
+// Reconcile compares the hashes of the saved blocks with the ones in the chain and
+// deletes the data saved for all the blocks above the latest block that exists in both.
+// It returns the height of the latest saved block that exists in the chain, which
+// is zero when none of them exist, and true when the saved data was rolled back.
+// Data backend errors are wrapped so they can be distinguished from node errors.
+func (r Reconciler) Reconcile(ctx context.Context) (height int64, rolledBack bool, err error) {
+	latest, err := r.db.GetCheckpoints(ctx, math.MaxInt64, 1)
+	if err != nil {
+		return 0, false, backendError{err}
+	}
+
+	// There is nothing to reconcile when no blocks are saved
+	if len(latest) == 0 {
+		return 0, false, nil
+	}
+
+	latestHeight, err := r.client.LatestBlockHeight(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// Saved blocks above the latest chain height don't exist in the chain
+	// so the common ancestor is searched from the latest chain block.
+	height, err = r.findCommonAncestor(ctx, latestHeight)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if height >= latest[0].Height {
+		return height, false, nil
+	}
+
+	if err := r.db.Rollback(ctx, height); err != nil {
+		return 0, false, backendError{err}
+	}
+
+	return height, true, nil
+}
+
+// findCommonAncestor returns the height of the latest saved block that has
+// the same hash in the chain or zero when there is no common block.
+func (r Reconciler) findCommonAncestor(ctx context.Context, toHeight int64) (int64, error) {
+	for toHeight > 0 {
+		checkpoints, err := r.db.GetCheckpoints(ctx, toHeight, checkpointsPageSize)
+		if err != nil {
+			return 0, backendError{err}
+		}
+
+		for _, cp := range checkpoints {
+			hash, err := r.client.GetBlockHash(ctx, cp.Height)
+			if err != nil {
+				return 0, err
+			}
+
+			// Blocks saved without a hash can't be compared so they are trusted
+			if cp.Hash == "" || cp.Hash == hash {
+				return cp.Height, nil
+			}
+		}
+
+		if len(checkpoints) < checkpointsPageSize {
+			break
+		}
+
+		toHeight = checkpoints[len(checkpoints)-1].Height - 1
+	}
+
+	return 0, nil
+}
@@ -1,6 +1,7 @@
 package gocmd
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -34,6 +35,9 @@ const (
 
 	// CommandFmt represents go "fmt" command.
 	CommandFmt = "fmt"
+
+	// CommandEnv represents go "env" command.
+	CommandEnv = "env"
 )
 
 const (
@@ -62,6 +66,21 @@ func Fmt(ctx context.Context, path string, options ...exec.Option) error {
 	return exec.Exec(ctx, []string{Name(), CommandFmt, "./..."}, append(options, exec.StepOption(step.Workdir(path)))...)
 }
 
+// Env returns the values of the Go environment variables names, as seen from path.
+func Env(ctx context.Context, path string, names ...string) ([]string, error) {
+	var b bytes.Buffer
+	command := append([]string{Name(), CommandEnv}, names...)
+	err := exec.Exec(ctx, command, exec.StepOption(step.Workdir(path)), exec.StepOption(step.Stdout(&b)))
+	if err != nil {
+		return nil, err
+	}
+	values := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if len(values) != len(names) {
+		return nil, fmt.Errorf("unexpected go env output %q", b.String())
+	}
+	return values, nil
+}
+
 // ModTidy runs go mod tidy on path with options.
 func ModTidy(ctx context.Context, path string, options ...exec.Option) error {
 	return exec.Exec(ctx, []string{Name(), CommandMod, CommandModTidy}, append(options, exec.StepOption(step.Workdir(path)))...)
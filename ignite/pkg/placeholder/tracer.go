@@ -1,6 +1,7 @@
 package placeholder
 
 import (
+	"sort"
 	"strings"
 )
 
@@ -30,6 +31,27 @@ func WithAdditionalInfo(info string) Option {
 	}
 }
 
+// WithLocator sets the locator used to find where a replacement must be inserted
+// when its placeholder is missing from the content.
+func WithLocator(locator Locator) Option {
+	return func(s *Tracer) {
+		s.locator = locator
+	}
+}
+
+// Location is the position in a content where a missing placeholder would be.
+type Location struct {
+	// Offset is the offset of the line before which the replacement is inserted.
+	Offset int
+
+	// Indent is the indentation of the inserted replacement.
+	Indent string
+}
+
+// Locator returns the locations of a placeholder missing from the content.
+// No location is returned when the placeholder can't be located.
+type Locator func(content, placeholder string) []Location
+
 // New instantiates Session with provided options.
 func New(opts ...Option) *Tracer {
 	s := &Tracer{missing: iterableStringSet{}}
@@ -43,6 +65,7 @@ type Replacer interface {
 	Replace(content, placeholder, replacement string) string
 	ReplaceAll(content, placeholder, replacement string) string
 	ReplaceOnce(content, placeholder, replacement string) string
+	Append(content, snippet string) string
 	AppendMiscError(miscError string)
 }
 
@@ -51,14 +74,17 @@ type Tracer struct {
 	missing        iterableStringSet
 	miscErrors     []string
 	additionalInfo string
+	insertions     []string
+	reused         []string
+	locator        Locator
 }
 
 // ReplaceAll replace all placeholders in content with replacement string.
 func (t *Tracer) ReplaceAll(content, placeholder, replacement string) string {
 	if strings.Count(content, placeholder) == 0 {
-		t.missing.Add(placeholder)
-		return content
+		return t.insert(content, placeholder, replacement, true)
 	}
+	t.addInsertions(placeholder, replacement)
 	return strings.ReplaceAll(content, placeholder, replacement)
 }
 
@@ -67,20 +93,105 @@ func (t *Tracer) Replace(content, placeholder, replacement string) string {
 	// NOTE(dshulyak) we will count twice. once here and second time in strings.Replace
 	// if it turns out to be an issue, copy the code from strings.Replace.
 	if strings.Count(content, placeholder) == 0 {
-		t.missing.Add(placeholder)
-		return content
+		return t.insert(content, placeholder, replacement, false)
 	}
+	t.addInsertions(placeholder, replacement)
 	return strings.Replace(content, placeholder, replacement, 1)
 }
 
 // ReplaceOnce will replace placeholder in content only if replacement is not already found in content.
+// The replacement already found is kept track of as reused.
 func (t *Tracer) ReplaceOnce(content, placeholder, replacement string) string {
-	if !strings.Contains(content, replacement) {
-		return t.Replace(content, placeholder, replacement)
+	snippet := snippetOf(placeholder, replacement)
+	if strings.Contains(content, replacement) {
+		t.reused = append(t.reused, snippet)
+		return content
+	}
+	// the replacement is inserted without its placeholder and reindented when the placeholder is missing
+	if !strings.Contains(content, placeholder) && strings.Contains(NormalizeSpaces(content), NormalizeSpaces(snippet)) {
+		t.reused = append(t.reused, snippet)
+		return content
+	}
+	return t.Replace(content, placeholder, replacement)
+}
+
+// Append appends a snippet at the end of the content and keeps track of it.
+func (t *Tracer) Append(content, snippet string) string {
+	t.insertions = append(t.insertions, snippet)
+	return content + snippet
+}
+
+// insert inserts the replacement without its placeholder at the locations found by the locator
+// when the placeholder is missing from the content. When all is false, the replacement is only
+// inserted at the first location. The placeholder is reported as missing if it can't be located.
+func (t *Tracer) insert(content, placeholder, replacement string, all bool) string {
+	var locations []Location
+	if t.locator != nil {
+		locations = t.locator(content, placeholder)
+	}
+	if len(locations) == 0 {
+		t.missing.Add(placeholder)
+		return content
+	}
+	if !all {
+		locations = locations[:1]
+	}
+
+	snippet := snippetOf(placeholder, replacement)
+	if snippet == "" {
+		return content
+	}
+
+	// insert from the end of the content to keep the offsets of the previous locations valid
+	sort.Slice(locations, func(i, j int) bool {
+		return locations[i].Offset > locations[j].Offset
+	})
+	for _, location := range locations {
+		lines := strings.Split(snippet, "\n")
+		for i, line := range lines {
+			if strings.TrimSpace(line) != "" {
+				lines[i] = location.Indent + line
+			}
+		}
+		inserted := strings.Join(lines, "\n") + "\n"
+		t.insertions = append(t.insertions, inserted)
+		content = content[:location.Offset] + inserted + content[location.Offset:]
 	}
 	return content
 }
 
+// snippetOf returns the code of a replacement without its placeholder.
+func snippetOf(placeholder, replacement string) string {
+	snippet := strings.ReplaceAll(replacement, placeholder, "")
+	return strings.TrimRight(strings.TrimLeft(snippet, "\n"), " \t\n")
+}
+
+// Insertions returns the snippets inserted at the placeholders, in the order of the replacements.
+func (t *Tracer) Insertions() []string {
+	return t.insertions
+}
+
+// Reused returns the snippets not inserted by ReplaceOnce because they were already
+// in the content, in the order of the replacements.
+func (t *Tracer) Reused() []string {
+	return t.reused
+}
+
+// NormalizeSpaces returns the code with its whitespaces normalized, to compare code
+// snippets that could have been formatted or reindented.
+func NormalizeSpaces(code string) string {
+	return strings.Join(strings.Fields(code), " ")
+}
+
+// addInsertions keeps track of the snippets of a replacement inserted around the placeholder.
+func (t *Tracer) addInsertions(placeholder, replacement string) {
+	for _, snippet := range strings.Split(replacement, placeholder) {
+		if strings.TrimSpace(snippet) != "" {
+			t.insertions = append(t.insertions, snippet)
+		}
+	}
+}
+
 // AppendMiscError allows to track errors not related to missing placeholders during file modification
 func (t *Tracer) AppendMiscError(miscError string) {
 	t.miscErrors = append(t.miscErrors, miscError)
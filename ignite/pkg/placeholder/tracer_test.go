@@ -1,6 +1,7 @@
 package placeholder
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -95,3 +96,45 @@ func TestReplaceAll(t *testing.T) {
 		})
 	}
 }
+
+func TestInsertions(t *testing.T) {
+	tr := New()
+	content := tr.Replace("// #one\n// #two", "// #one", "foo()\n// #one")
+	content = tr.Replace(content, "// #two", "// #two\nbar()")
+	content = tr.Replace(content, "// #three", "baz()\n// #three")
+	content = tr.Replace(content, "// #one", "\n// #one")
+
+	require.Equal(t, "foo()\n\n// #one\n// #two\nbar()", content)
+	require.Equal(t, []string{"foo()\n", "\nbar()"}, tr.Insertions())
+}
+
+func TestReplaceOnceReused(t *testing.T) {
+	tr := New()
+	content := tr.ReplaceOnce("// #one", "// #one", "foo()\n// #one")
+	content = tr.ReplaceOnce(content, "// #one", "foo()\n// #one")
+	content = tr.Append(content, "\nbar()")
+
+	require.Equal(t, "foo()\n// #one\nbar()", content)
+	require.Equal(t, []string{"foo()\n", "\nbar()"}, tr.Insertions())
+	require.Equal(t, []string{"foo()"}, tr.Reused())
+}
+
+func TestReplaceWithLocator(t *testing.T) {
+	locator := func(content, placeholder string) []Location {
+		if placeholder != "// #one" {
+			return nil
+		}
+		return []Location{{Offset: 0, Indent: "\t"}, {Offset: strings.Index(content, "}"), Indent: "\t"}}
+	}
+
+	tr := New(WithLocator(locator))
+	content := tr.Replace("{\n}", "// #one", "// #one\nfoo()\nbar()")
+	content = tr.ReplaceOnce(content, "// #one", "// #one\nfoo()\nbar()")
+	content = tr.ReplaceAll(content, "// #one", "baz()\n// #one")
+	content = tr.Replace(content, "// #two", "// #two\nfoo()")
+
+	require.Equal(t, "\tbaz()\n\tfoo()\n\tbar()\n{\n\tbaz()\n}", content)
+	require.Equal(t, []string{"\tfoo()\n\tbar()\n", "\tbaz()\n", "\tbaz()\n"}, tr.Insertions())
+	require.Equal(t, []string{"foo()\nbar()"}, tr.Reused())
+	require.ErrorIs(t, tr.Err(), newErrMissingPlaceholder([]string{"// #two"}))
+}
@@ -0,0 +1,147 @@
+package xast
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// The functions below locate anchors in the AST of a Go file, they are used to find
+// where code can be inserted in a file. Each of them returns token.NoPos when the
+// anchor can't be found.
+
+// FuncBodyEnd returns the position of the closing brace of the body of the function
+// or method named funcName.
+func FuncBodyEnd(f *ast.File, funcName string) token.Pos {
+	fn := findFunc(f, funcName)
+	if fn == nil {
+		return token.NoPos
+	}
+	return fn.Body.Rbrace
+}
+
+// LastReturn returns the position of the last return statement of the body of the
+// function or method named funcName.
+func LastReturn(f *ast.File, funcName string) token.Pos {
+	fn := findFunc(f, funcName)
+	if fn == nil {
+		return token.NoPos
+	}
+	for i := len(fn.Body.List) - 1; i >= 0; i-- {
+		if _, ok := fn.Body.List[i].(*ast.ReturnStmt); ok {
+			return fn.Body.List[i].Pos()
+		}
+	}
+	return token.NoPos
+}
+
+// StmtCalling returns the position of the first statement of the body of the function
+// or method named funcName that calls a function or a method named callName.
+func StmtCalling(f *ast.File, funcName, callName string) token.Pos {
+	fn := findFunc(f, funcName)
+	if fn == nil {
+		return token.NoPos
+	}
+	for _, stmt := range fn.Body.List {
+		found := false
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			if call, ok := n.(*ast.CallExpr); ok && isCallTo(call, callName) {
+				found = true
+			}
+			return !found
+		})
+		if found {
+			return stmt.Pos()
+		}
+	}
+	return token.NoPos
+}
+
+// CallArgsEnd returns the positions of the closing parenthesis of every call to a
+// function or a method named callName.
+func CallArgsEnd(f *ast.File, callName string) (positions []token.Pos) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && isCallTo(call, callName) {
+			positions = append(positions, call.Rparen)
+		}
+		return true
+	})
+	return positions
+}
+
+// CompositeLitEnd returns the position of the closing brace of the composite literal
+// assigned to the variable named varName.
+func CompositeLitEnd(f *ast.File, varName string) (pos token.Pos) {
+	// assigned checks if the expression is the variable and its value a composite literal
+	assigned := func(name, value ast.Expr) {
+		ident, ok := name.(*ast.Ident)
+		if !ok || ident.Name != varName {
+			return
+		}
+		if lit, ok := value.(*ast.CompositeLit); ok {
+			pos = lit.Rbrace
+		}
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			for i := 0; i < len(n.Names) && i < len(n.Values); i++ {
+				assigned(n.Names[i], n.Values[i])
+			}
+		case *ast.AssignStmt:
+			for i := 0; i < len(n.Lhs) && i < len(n.Rhs); i++ {
+				assigned(n.Lhs[i], n.Rhs[i])
+			}
+		}
+		return pos == token.NoPos
+	})
+	return pos
+}
+
+// StructFieldsEnd returns the position of the closing brace of the fields of the
+// struct type named typeName.
+func StructFieldsEnd(f *ast.File, typeName string) (pos token.Pos) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.TypeSpec)
+		if !ok || spec.Name.Name != typeName {
+			return pos == token.NoPos
+		}
+		if st, ok := spec.Type.(*ast.StructType); ok {
+			pos = st.Fields.Closing
+		}
+		return false
+	})
+	return pos
+}
+
+// ImportsEnd returns the position of the closing parenthesis of the last grouped
+// import declaration.
+func ImportsEnd(f *ast.File) (pos token.Pos) {
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if ok && gen.Tok == token.IMPORT && gen.Rparen.IsValid() {
+			pos = gen.Rparen
+		}
+	}
+	return pos
+}
+
+// findFunc returns the declaration of the function or method named funcName.
+func findFunc(f *ast.File, funcName string) *ast.FuncDecl {
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Name.Name == funcName && fn.Body != nil {
+			return fn
+		}
+	}
+	return nil
+}
+
+// isCallTo checks if the call expression calls a function or a method named name.
+func isCallTo(call *ast.CallExpr, name string) bool {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name == name
+	case *ast.SelectorExpr:
+		return fun.Sel.Name == name
+	}
+	return false
+}
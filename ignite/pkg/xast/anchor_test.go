@@ -0,0 +1,88 @@
+package xast_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xast"
+)
+
+func TestAnchors(t *testing.T) {
+	fileSet := token.NewFileSet()
+	f, err := parser.ParseFile(fileSet, "testdata/anchor/anchor.go", nil, 0)
+	require.NoError(t, err)
+
+	one := func(pos token.Pos) []token.Pos {
+		return []token.Pos{pos}
+	}
+	tests := []struct {
+		name string
+		find func(f *ast.File) []token.Pos
+		want []string
+	}{
+		{
+			name: "func body end",
+			find: func(f *ast.File) []token.Pos { return one(xast.FuncBodyEnd(f, "New")) },
+			want: []string{"26:1"},
+		},
+		{
+			name: "last return",
+			find: func(f *ast.File) []token.Pos { return one(xast.LastReturn(f, "New")) },
+			want: []string{"25:2"},
+		},
+		{
+			name: "no return",
+			find: func(f *ast.File) []token.Pos { return one(xast.LastReturn(f, "Empty")) },
+			want: []string{"-"},
+		},
+		{
+			name: "statement calling",
+			find: func(f *ast.File) []token.Pos { return one(xast.StmtCalling(f, "New", "Sprint")) },
+			want: []string{"21:2"},
+		},
+		{
+			name: "call args end",
+			find: func(f *ast.File) []token.Pos { return xast.CallArgsEnd(f, "Sprint") },
+			want: []string{"23:2"},
+		},
+		{
+			name: "composite literal end",
+			find: func(f *ast.File) []token.Pos { return one(xast.CompositeLitEnd(f, "perms")) },
+			want: []string{"14:1"},
+		},
+		{
+			name: "struct fields end",
+			find: func(f *ast.File) []token.Pos { return one(xast.StructFieldsEnd(f, "App")) },
+			want: []string{"10:1"},
+		},
+		{
+			name: "imports end",
+			find: func(f *ast.File) []token.Pos { return one(xast.ImportsEnd(f)) },
+			want: []string{"6:1"},
+		},
+		{
+			name: "missing function",
+			find: func(f *ast.File) []token.Pos { return one(xast.FuncBodyEnd(f, "Foo")) },
+			want: []string{"-"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, pos := range tt.find(f) {
+				if !pos.IsValid() {
+					got = append(got, "-")
+					continue
+				}
+				p := fileSet.Position(pos)
+				got = append(got, fmt.Sprintf("%d:%d", p.Line, p.Column))
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package anchor
+
+import (
+	"fmt"
+	"strings"
+)
+
+type App struct {
+	Name string
+}
+
+var perms = map[string]int{
+	"a": 1,
+}
+
+func New() *App {
+	keys := strings.Join([]string{
+		"a",
+	}, ",")
+	fmt.Println(keys)
+	manager := fmt.Sprint(
+		"b",
+	)
+	fmt.Println(manager)
+	return &App{}
+}
+
+func Empty() {}
@@ -0,0 +1,104 @@
+package xgenny
+
+import (
+	"os"
+	"sort"
+
+	"github.com/gobuffalo/genny"
+)
+
+// DryRun is the in-memory file system written by the generators run with
+// DryRunWithValidation, the disk is left untouched.
+type DryRun struct {
+	files   map[string]string
+	removed map[string]struct{}
+}
+
+// NewDryRun returns an empty in-memory file system for dry runs.
+func NewDryRun() *DryRun {
+	return &DryRun{
+		files:   make(map[string]string),
+		removed: make(map[string]struct{}),
+	}
+}
+
+// Files returns the sorted paths of the files created, modified or removed by the dry runs.
+func (d *DryRun) Files() []string {
+	files := make([]string, 0, len(d.files)+len(d.removed))
+	for file := range d.files {
+		files = append(files, file)
+	}
+	for file := range d.removed {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// Content returns the content of a file created or modified by the dry runs.
+// False is returned when the file is removed.
+func (d *DryRun) Content(path string) (string, bool) {
+	content, ok := d.files[path]
+	return content, ok
+}
+
+// ReadFile reads a file as modified by the dry runs, the file is read from the
+// disk when the dry runs didn't change it.
+func (d *DryRun) ReadFile(path string) ([]byte, error) {
+	if _, ok := d.removed[path]; ok {
+		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+	if content, ok := d.files[path]; ok {
+		return []byte(content), nil
+	}
+	return os.ReadFile(path)
+}
+
+// WriteFile writes the content of a file without modifying the disk.
+func (d *DryRun) WriteFile(path, content string) error {
+	delete(d.removed, path)
+	return d.add(genny.NewFileS(path, content))
+}
+
+// RemoveFile removes a file without modifying the disk.
+func (d *DryRun) RemoveFile(path string) error {
+	if _, err := d.ReadFile(path); err != nil {
+		return err
+	}
+	delete(d.files, path)
+	if _, err := os.Stat(path); err == nil {
+		d.removed[path] = struct{}{}
+	}
+	return nil
+}
+
+// mount adds the files written by the previous dry runs to the virtual disk of a runner.
+func (d *DryRun) mount(runner *genny.Runner) {
+	for name, content := range d.files {
+		runner.Disk.Add(genny.NewFileS(name, content))
+	}
+	for name := range d.removed {
+		runner.Disk.Remove(name)
+	}
+}
+
+// add keeps the files written by a dry run when their content differs from the disk.
+func (d *DryRun) add(files ...genny.File) error {
+	for _, file := range files {
+		if _, ok := file.(genny.Dir); ok {
+			continue
+		}
+		name, content := file.Name(), file.String()
+		delete(d.removed, name)
+		current, err := os.ReadFile(name)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err == nil && string(current) == content {
+			delete(d.files, name)
+			continue
+		}
+		d.files[name] = content
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package xgenny_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+func TestDryRunFiles(t *testing.T) {
+	// Arrange
+	var (
+		dir          = t.TempDir()
+		modifiedFile = filepath.Join(dir, "app.go")
+		removedFile  = filepath.Join(dir, "foo.go")
+		createdFile  = filepath.Join(dir, "bar.go")
+		dryRun       = xgenny.NewDryRun()
+	)
+	require.NoError(t, os.WriteFile(modifiedFile, []byte("package app\n"), 0o644))
+	require.NoError(t, os.WriteFile(removedFile, []byte("package foo\n"), 0o644))
+
+	// Act
+	require.NoError(t, dryRun.WriteFile(modifiedFile, "package app\nvar app = 1\n"))
+	require.NoError(t, dryRun.WriteFile(createdFile, "package bar\n"))
+	require.NoError(t, dryRun.RemoveFile(createdFile))
+	require.NoError(t, dryRun.RemoveFile(removedFile))
+	removeErr := dryRun.RemoveFile(removedFile)
+
+	// Assert
+	require.ErrorIs(t, removeErr, os.ErrNotExist)
+	require.Equal(t, []string{modifiedFile, removedFile}, dryRun.Files())
+
+	content, err := dryRun.ReadFile(modifiedFile)
+	require.NoError(t, err)
+	require.Equal(t, "package app\nvar app = 1\n", string(content))
+	_, err = dryRun.ReadFile(removedFile)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	_, ok := dryRun.Content(removedFile)
+	require.False(t, ok)
+
+	// the disk is left untouched
+	require.FileExists(t, removedFile)
+	require.NoFileExists(t, createdFile)
+	current, err := os.ReadFile(modifiedFile)
+	require.NoError(t, err)
+	require.Equal(t, "package app\n", string(current))
+}
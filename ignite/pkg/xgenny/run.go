@@ -36,6 +36,25 @@ func DryRunner(ctx context.Context) *genny.Runner {
 func RunWithValidation(
 	tracer *placeholder.Tracer,
 	gens ...*genny.Generator,
+) (sm SourceModification, err error) {
+	return runWithValidation(tracer, nil, gens...)
+}
+
+// DryRunWithValidation checks the generators with a dry run like RunWithValidation but doesn't
+// execute them on the disk, the files they write are kept in memory by dryRun instead.
+// The generators see the files written by the previous dry runs.
+func DryRunWithValidation(
+	tracer *placeholder.Tracer,
+	dryRun *DryRun,
+	gens ...*genny.Generator,
+) (sm SourceModification, err error) {
+	return runWithValidation(tracer, dryRun, gens...)
+}
+
+func runWithValidation(
+	tracer *placeholder.Tracer,
+	dryRun *DryRun,
+	gens ...*genny.Generator,
 ) (sm SourceModification, err error) {
 	// run executes the provided runner with the provided generator
 	run := func(runner *genny.Runner, gen *genny.Generator) error {
@@ -45,9 +64,14 @@ func RunWithValidation(
 		}
 		return runner.Run()
 	}
+	sm = NewSourceModification()
 	for _, gen := range gens {
 		// check with a dry runner the generators
+		insertionsCount, reusedCount := len(tracer.Insertions()), len(tracer.Reused())
 		dryRunner := DryRunner(context.Background())
+		if dryRun != nil {
+			dryRun.mount(dryRunner)
+		}
 		if err := run(dryRunner, gen); err != nil {
 			if errors.Is(err, os.ErrNotExist) {
 				return sm, &dryRunError{err}
@@ -57,12 +81,13 @@ func RunWithValidation(
 		if err := tracer.Err(); err != nil {
 			return sm, err
 		}
+		insertions := tracer.Insertions()[insertionsCount:]
+		reused := tracer.Reused()[reusedCount:]
 
 		// fetch the source modification
-		sm = NewSourceModification()
 		for _, file := range dryRunner.Results().Files {
 			fileName := file.Name()
-			_, err := os.Stat(fileName)
+			content, err := os.ReadFile(fileName)
 
 			// nolint:gocritic
 			if os.IsNotExist(err) {
@@ -73,9 +98,24 @@ func RunWithValidation(
 			} else {
 				// the file has been modified by the runner
 				sm.AppendModifiedFiles(fileName)
+				if dryRun != nil {
+					if previous, ok := dryRun.files[fileName]; ok {
+						content = []byte(previous)
+					}
+				}
+				sm.AppendInsertions(fileName, insertedSnippets(string(content), file.String(), insertions)...)
+				sm.AppendReused(fileName, reusedSnippets(file.String(), reused)...)
 			}
 		}
 
+		// keep the modification in memory for a dry run
+		if dryRun != nil {
+			if err := dryRun.add(dryRunner.Results().Files...); err != nil {
+				return sm, err
+			}
+			continue
+		}
+
 		// execute the modification with a wet runner
 		if err := run(genny.WetRunner(context.Background()), gen); err != nil {
 			return sm, err
@@ -102,3 +142,36 @@ func Box(g *genny.Generator, box packd.Walker) error {
 		return err
 	})
 }
+
+// insertedSnippets returns the snippets inserted at placeholders that were added
+// to the content of a modified file, once for each time they were inserted.
+func insertedSnippets(content, newContent string, insertions []string) (snippets []string) {
+	seen := make(map[string]struct{})
+	for _, snippet := range insertions {
+		if _, ok := seen[snippet]; ok {
+			continue
+		}
+		seen[snippet] = struct{}{}
+
+		for i := strings.Count(content, snippet); i < strings.Count(newContent, snippet); i++ {
+			snippets = append(snippets, snippet)
+		}
+	}
+	return snippets
+}
+
+// reusedSnippets returns the snippets already inserted that are found in the content of a modified file.
+func reusedSnippets(content string, reused []string) (snippets []string) {
+	seen := make(map[string]struct{})
+	for _, snippet := range reused {
+		if _, ok := seen[snippet]; ok {
+			continue
+		}
+		seen[snippet] = struct{}{}
+
+		if strings.Contains(placeholder.NormalizeSpaces(content), placeholder.NormalizeSpaces(snippet)) {
+			snippets = append(snippets, snippet)
+		}
+	}
+	return snippets
+}
@@ -0,0 +1,107 @@
+package xgenny_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/genny"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+func TestRunWithValidation(t *testing.T) {
+	// Arrange
+	var (
+		dir          = t.TempDir()
+		modifiedFile = filepath.Join(dir, "app.go")
+		createdFile  = filepath.Join(dir, "foo.go")
+		tracer       = placeholder.New()
+	)
+	err := os.WriteFile(modifiedFile, []byte("import \"bar\"\n// this line is used by starport scaffolding # 1\n"), 0o644)
+	require.NoError(t, err)
+
+	g := genny.New()
+	g.File(genny.NewFileS(createdFile, "package foo"))
+	g.RunFn(func(r *genny.Runner) error {
+		f, err := r.Disk.Find(modifiedFile)
+		if err != nil {
+			return err
+		}
+		placeholder := "// this line is used by starport scaffolding # 1"
+		content := tracer.Replace(f.String(), placeholder, "import \"bar\"\n"+placeholder)
+		content = tracer.Replace(content, placeholder, "import \"foo\"\n"+placeholder)
+		content = tracer.ReplaceOnce(content, placeholder, "import \"foo\"\n"+placeholder)
+		return r.File(genny.NewFileS(modifiedFile, content))
+	})
+
+	// Act
+	sm, err := xgenny.RunWithValidation(tracer, g)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{createdFile}, sm.CreatedFiles())
+	require.Equal(t, []string{modifiedFile}, sm.ModifiedFiles())
+	require.Equal(t, []string{"import \"bar\"\n", "import \"foo\"\n"}, sm.Insertions(modifiedFile))
+	require.Equal(t, []string{"import \"foo\""}, sm.Reused(modifiedFile))
+	require.FileExists(t, createdFile)
+}
+
+func TestDryRunWithValidation(t *testing.T) {
+	// Arrange
+	var (
+		dir             = t.TempDir()
+		modifiedFile    = filepath.Join(dir, "app.go")
+		createdFile     = filepath.Join(dir, "foo.go")
+		tracer          = placeholder.New()
+		dryRun          = xgenny.NewDryRun()
+		scaffoldingLine = "// this line is used by starport scaffolding # 1"
+		appContent      = "package app\n" + scaffoldingLine + "\n"
+	)
+	err := os.WriteFile(modifiedFile, []byte(appContent), 0o644)
+	require.NoError(t, err)
+
+	// replace returns a generator inserting a line before the placeholder of a file
+	replace := func(path, line string) *genny.Generator {
+		g := genny.New()
+		g.RunFn(func(r *genny.Runner) error {
+			f, err := r.Disk.Find(path)
+			if err != nil {
+				return err
+			}
+			content := tracer.Replace(f.String(), scaffoldingLine, line+"\n"+scaffoldingLine)
+			return r.File(genny.NewFileS(path, content))
+		})
+		return g
+	}
+	create := genny.New()
+	create.File(genny.NewFileS(createdFile, "package foo\n"+scaffoldingLine+"\n"))
+
+	// Act
+	sm, err := xgenny.DryRunWithValidation(tracer, dryRun, create, replace(createdFile, "var foo = 1"))
+	require.NoError(t, err)
+	appSm, err := xgenny.DryRunWithValidation(tracer, dryRun, replace(modifiedFile, "var app = 1"))
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, []string{createdFile}, sm.CreatedFiles())
+	require.Empty(t, sm.ModifiedFiles())
+	require.Equal(t, []string{modifiedFile}, appSm.ModifiedFiles())
+	require.Equal(t, []string{"var app = 1\n"}, appSm.Insertions(modifiedFile))
+	require.Equal(t, []string{modifiedFile, createdFile}, dryRun.Files())
+
+	content, ok := dryRun.Content(createdFile)
+	require.True(t, ok)
+	require.Equal(t, "package foo\nvar foo = 1\n"+scaffoldingLine+"\n", content)
+	content, ok = dryRun.Content(modifiedFile)
+	require.True(t, ok)
+	require.Equal(t, "package app\nvar app = 1\n"+scaffoldingLine+"\n", content)
+
+	// the disk is left untouched
+	require.NoFileExists(t, createdFile)
+	current, err := os.ReadFile(modifiedFile)
+	require.NoError(t, err)
+	require.Equal(t, appContent, string(current))
+}
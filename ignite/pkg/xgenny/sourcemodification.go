@@ -4,12 +4,18 @@ package xgenny
 type SourceModification struct {
 	modified map[string]struct{}
 	created  map[string]struct{}
+	removed  map[string]struct{}
+	inserted map[string][]string
+	reused   map[string][]string
 }
 
 func NewSourceModification() SourceModification {
 	return SourceModification{
 		make(map[string]struct{}),
 		make(map[string]struct{}),
+		make(map[string]struct{}),
+		make(map[string][]string),
+		make(map[string][]string),
 	}
 }
 
@@ -29,12 +35,32 @@ func (sm SourceModification) CreatedFiles() (createdFiles []string) {
 	return
 }
 
+// RemovedFiles returns the removed files of the source modification
+func (sm SourceModification) RemovedFiles() (removedFiles []string) {
+	for removed := range sm.removed {
+		removedFiles = append(removedFiles, removed)
+	}
+	return
+}
+
+// Insertions returns the snippets inserted in a modified file
+func (sm SourceModification) Insertions(modifiedFile string) []string {
+	return sm.inserted[modifiedFile]
+}
+
+// Reused returns the snippets of a modified file that were already inserted and that
+// the source modification relies on
+func (sm SourceModification) Reused(modifiedFile string) []string {
+	return sm.reused[modifiedFile]
+}
+
 // AppendModifiedFiles appends modified files in the source modification that are not already documented
 func (sm *SourceModification) AppendModifiedFiles(modifiedFiles ...string) {
 	for _, modifiedFile := range modifiedFiles {
 		_, alreadyModified := sm.modified[modifiedFile]
 		_, alreadyCreated := sm.created[modifiedFile]
-		if !alreadyModified && !alreadyCreated {
+		_, alreadyRemoved := sm.removed[modifiedFile]
+		if !alreadyModified && !alreadyCreated && !alreadyRemoved {
 			sm.modified[modifiedFile] = struct{}{}
 		}
 	}
@@ -51,8 +77,43 @@ func (sm *SourceModification) AppendCreatedFiles(createdFiles ...string) {
 	}
 }
 
+// AppendRemovedFiles appends removed files in the source modification, a removed file is no longer modified
+func (sm *SourceModification) AppendRemovedFiles(removedFiles ...string) {
+	for _, removedFile := range removedFiles {
+		delete(sm.modified, removedFile)
+		delete(sm.inserted, removedFile)
+		delete(sm.reused, removedFile)
+		sm.removed[removedFile] = struct{}{}
+	}
+}
+
+// AppendInsertions appends the snippets inserted in a modified file of the source modification
+func (sm *SourceModification) AppendInsertions(modifiedFile string, snippets ...string) {
+	if _, alreadyCreated := sm.created[modifiedFile]; alreadyCreated {
+		return
+	}
+	sm.AppendModifiedFiles(modifiedFile)
+	sm.inserted[modifiedFile] = append(sm.inserted[modifiedFile], snippets...)
+}
+
+// AppendReused appends the snippets already inserted in a modified file that the source modification relies on
+func (sm *SourceModification) AppendReused(modifiedFile string, snippets ...string) {
+	if _, alreadyCreated := sm.created[modifiedFile]; alreadyCreated {
+		return
+	}
+	sm.AppendModifiedFiles(modifiedFile)
+	sm.reused[modifiedFile] = append(sm.reused[modifiedFile], snippets...)
+}
+
 // Merge merges new source modification to an existing one
 func (sm *SourceModification) Merge(newSm SourceModification) {
 	sm.AppendModifiedFiles(newSm.ModifiedFiles()...)
 	sm.AppendCreatedFiles(newSm.CreatedFiles()...)
+	sm.AppendRemovedFiles(newSm.RemovedFiles()...)
+	for modifiedFile, snippets := range newSm.inserted {
+		sm.AppendInsertions(modifiedFile, snippets...)
+	}
+	for modifiedFile, snippets := range newSm.reused {
+		sm.AppendReused(modifiedFile, snippets...)
+	}
 }
@@ -81,3 +81,30 @@ func TestMerge(t *testing.T) {
 	require.Subset(t, sm1.ModifiedFiles(), []string{"foo1", "foo2", "foo3", "foo4", "foo5"})
 	require.Subset(t, sm1.CreatedFiles(), []string{"bar1", "bar2", "bar3"})
 }
+
+func TestAppendRemovedFiles(t *testing.T) {
+	sm := sourceModificationExample()
+	sm.AppendInsertions("mfoo", "foo()")
+	sm.AppendRemovedFiles("mfoo", "foo1")
+	require.Len(t, sm.ModifiedFiles(), len(modifiedExample)-1)
+	require.NotContains(t, sm.ModifiedFiles(), "mfoo")
+	require.Empty(t, sm.Insertions("mfoo"))
+	require.ElementsMatch(t, []string{"mfoo", "foo1"}, sm.RemovedFiles())
+
+	// A removed file is no longer modified
+	sm.AppendModifiedFiles("foo1")
+	require.NotContains(t, sm.ModifiedFiles(), "foo1")
+}
+
+func TestAppendInsertions(t *testing.T) {
+	sm := sourceModificationExample()
+	sm.AppendInsertions("foo1", "foo()", "bar()")
+	sm.AppendInsertions("foo1", "foo()")
+	require.Equal(t, []string{"foo()", "bar()", "foo()"}, sm.Insertions("foo1"))
+	require.Contains(t, sm.ModifiedFiles(), "foo1")
+
+	// Insertions in created files are not documented
+	sm.AppendInsertions("cfoo", "foo()")
+	require.Empty(t, sm.Insertions("cfoo"))
+	require.NotContains(t, sm.ModifiedFiles(), "cfoo")
+}
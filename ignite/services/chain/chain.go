@@ -72,6 +72,9 @@ type chainOptions struct {
 
 	// path of a custom config file
 	ConfigFile string
+
+	// names of the config overlays merged on top of the config file
+	configOverlays []string
 }
 
 // Option configures Chain.
@@ -105,6 +108,14 @@ func ConfigFile(configFile string) Option {
 	}
 }
 
+// ConfigOverlays specifies the names of the config overlay files to merge
+// on top of the config file, for example "ci" for "config.ci.yml".
+func ConfigOverlays(names ...string) Option {
+	return func(c *Chain) {
+		c.options.configOverlays = names
+	}
+}
+
 // WithOutputer sets the CLI outputer for the chain.
 func WithOutputer(s uilog.Outputer) Option {
 	return func(c *Chain) {
@@ -215,7 +226,28 @@ func (c *Chain) Config() (*chainconfig.Config, error) {
 	if configPath == "" {
 		return chainconfig.DefaultConfig(), nil
 	}
-	return chainconfig.ParseFile(configPath)
+	return chainconfig.ParseFile(configPath, c.options.configOverlays...)
+}
+
+// ConfigPaths returns the paths of the config file, its overlays and the files
+// referenced in the config values, like "${file:secrets/alice.txt}".
+// The list is empty when the chain has no defined config.
+func (c *Chain) ConfigPaths() []string {
+	configPath := c.ConfigPath()
+	if configPath == "" {
+		return nil
+	}
+
+	paths := []string{configPath}
+	for _, name := range c.options.configOverlays {
+		paths = append(paths, chainconfig.OverlayPath(configPath, name))
+	}
+
+	// The files referenced until the config can't be parsed are kept,
+	// the parsing error is returned when the config is read.
+	files, _ := chainconfig.ReferencedFiles(configPath, c.options.configOverlays...)
+
+	return append(paths, files...)
 }
 
 // ID returns the chain's id.
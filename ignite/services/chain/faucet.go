@@ -4,13 +4,22 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/pkg/errors"
 
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/cache"
 	chaincmdrunner "github.com/ignite/cli/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/ignite/pkg/cosmosfaucet/clientbackend"
 	"github.com/ignite/cli/ignite/pkg/xurl"
 )
 
@@ -22,6 +31,9 @@ var (
 	ErrFaucetAccountDoesNotExist = errors.New("specified account (faucet.name) does not exist")
 )
 
+// faucetLedgerFileName is the name of the default database file used by the embedded faucet ledger.
+const faucetLedgerFileName = "faucet.db"
+
 var envAPIAddress = os.Getenv("API_ADDRESS")
 
 // Faucet returns the faucet for the chain or an error if the faucet
@@ -47,7 +59,8 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		return cosmosfaucet.Faucet{}, ErrFaucetIsNotEnabled
 	}
 
-	if _, err := commands.ShowAccount(ctx, *conf.Faucet.Name); err != nil {
+	faucetAccount, err := commands.ShowAccount(ctx, *conf.Faucet.Name)
+	if err != nil {
 		if err == chaincmdrunner.ErrAccountDoesNotExist {
 			return cosmosfaucet.Faucet{}, ErrFaucetAccountDoesNotExist
 		}
@@ -110,6 +123,185 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		faucetOptions = append(faucetOptions, cosmosfaucet.RefreshWindow(rateLimitWindow))
 	}
 
+	rateLimits := []struct {
+		conf   chainconfig.RateLimit
+		option func(int, time.Duration) cosmosfaucet.Option
+	}{
+		{conf.Faucet.IPRateLimit, cosmosfaucet.IPRateLimit},
+		{conf.Faucet.GlobalRateLimit, cosmosfaucet.GlobalRateLimit},
+	}
+	for _, l := range rateLimits {
+		if l.conf.Requests == 0 {
+			continue
+		}
+
+		period, err := time.ParseDuration(l.conf.Period)
+		if err != nil {
+			return cosmosfaucet.Faucet{}, fmt.Errorf("invalid faucet rate limit period: %w", err)
+		}
+
+		faucetOptions = append(faucetOptions, l.option(l.conf.Requests, period))
+	}
+
+	if conf.Faucet.Challenge.Difficulty > 0 {
+		var ttl time.Duration
+		if conf.Faucet.Challenge.TTL != "" {
+			if ttl, err = time.ParseDuration(conf.Faucet.Challenge.TTL); err != nil {
+				return cosmosfaucet.Faucet{}, fmt.Errorf("invalid faucet challenge ttl: %w", err)
+			}
+		}
+
+		faucetOptions = append(faucetOptions, cosmosfaucet.Challenge(conf.Faucet.Challenge.Difficulty, ttl))
+	}
+
+	if conf.Faucet.Batch.Window != "" {
+		window, err := time.ParseDuration(conf.Faucet.Batch.Window)
+		if err != nil {
+			return cosmosfaucet.Faucet{}, fmt.Errorf("invalid faucet batch window: %w", err)
+		}
+
+		sender, err := c.faucetMultiSender(faucetAccount.Address, servers.RPC.Address)
+		if err != nil {
+			return cosmosfaucet.Faucet{}, err
+		}
+
+		faucetOptions = append(faucetOptions, cosmosfaucet.Batch(sender, window, conf.Faucet.Batch.MaxSize))
+	}
+
+	if len(conf.Faucet.LowBalance) > 0 {
+		threshold, err := sdk.ParseCoinsNormalized(strings.Join(conf.Faucet.LowBalance, ","))
+		if err != nil {
+			return cosmosfaucet.Faucet{}, fmt.Errorf("invalid faucet low balance: %w", err)
+		}
+
+		faucetOptions = append(faucetOptions, cosmosfaucet.LowBalance(threshold))
+	}
+
+	if conf.Faucet.AdminToken != "" {
+		faucetOptions = append(faucetOptions, cosmosfaucet.AdminToken(conf.Faucet.AdminToken))
+	}
+
+	faucetOptions = append(faucetOptions, cosmosfaucet.CollectEvents(c.ev))
+
+	ledger, err := faucetLedger(conf.Faucet.Ledger, commands, *conf.Faucet.Name, id)
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+
+	faucetOptions = append(faucetOptions, cosmosfaucet.WithLedger(ledger))
+
 	// init the faucet with options and return.
 	return cosmosfaucet.New(ctx, commands, faucetOptions...)
 }
+
+// DefaultFaucetLedgerPath returns the path of the database file used by default
+// by the embedded faucet ledger.
+func DefaultFaucetLedgerPath() (string, error) {
+	configDir, err := chainconfig.ConfigDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, faucetLedgerFileName), nil
+}
+
+// faucetLedger creates the faucet ledger for the backend selected in the config.
+func faucetLedger(
+	conf chainconfig.FaucetLedger,
+	commands chaincmdrunner.Runner,
+	accountName,
+	chainID string,
+) (cosmosfaucet.Ledger, error) {
+	switch conf.Backend {
+	case "", cosmosfaucet.LedgerBackendEmbedded:
+		path := conf.Path
+		if path == "" {
+			var err error
+			if path, err = DefaultFaucetLedgerPath(); err != nil {
+				return nil, err
+			}
+		}
+
+		storage, err := cache.NewStorage(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return cosmosfaucet.NewEmbeddedLedger(storage, chainID), nil
+	case cosmosfaucet.LedgerBackendTxEvents:
+		return cosmosfaucet.NewTxEventsLedger(commands, accountName), nil
+	default:
+		return nil, fmt.Errorf("unknown faucet ledger backend: %s", conf.Backend)
+	}
+}
+
+// faucetMultiSender creates a multi sender that signs the batched faucet
+// transfers with the faucet account from the chain's keyring.
+func (c *Chain) faucetMultiSender(faucetAddress, rpcAddress string) (*multiSender, error) {
+	home, err := c.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := c.KeyringBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	nodeAddress, err := xurl.HTTP(rpcAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, _, err := bech32.DecodeAndConvert(faucetAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &multiSender{
+		options: []cosmosclient.Option{
+			cosmosclient.WithHome(home),
+			cosmosclient.WithKeyringBackend(cosmosaccount.KeyringBackend(backend)),
+			cosmosclient.WithNodeAddress(nodeAddress),
+			cosmosclient.WithAddressPrefix(prefix),
+			cosmosclient.WithGas("auto"),
+		},
+	}, nil
+}
+
+// multiSender sends the batched faucet transfers with a cosmos client.
+// The client is created on the first send because the node might not be
+// running yet when the faucet is created.
+type multiSender struct {
+	options []cosmosclient.Option
+
+	mu      sync.Mutex
+	backend *clientbackend.Backend
+}
+
+// MultiSend implements cosmosfaucet.MultiSender.
+func (s *multiSender) MultiSend(ctx context.Context, fromName string, outputs []cosmosfaucet.Output) (string, error) {
+	backend, err := s.getBackend(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return backend.MultiSend(ctx, fromName, outputs)
+}
+
+func (s *multiSender) getBackend(ctx context.Context) (*clientbackend.Backend, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.backend == nil {
+		client, err := cosmosclient.New(ctx, s.options...)
+		if err != nil {
+			return nil, err
+		}
+
+		backend := clientbackend.New(client)
+		s.backend = &backend
+	}
+
+	return s.backend, nil
+}
@@ -274,9 +274,7 @@ func (c *Chain) refreshServe() {
 
 func (c *Chain) watchAppBackend(ctx context.Context) error {
 	watchPaths := appBackendSourceWatchPaths
-	if c.ConfigPath() != "" {
-		watchPaths = append(watchPaths, c.ConfigPath())
-	}
+	watchPaths = append(watchPaths, c.ConfigPaths()...)
 
 	return localfs.Watch(
 		ctx,
@@ -320,8 +318,8 @@ func (c *Chain) serve(
 	}
 	if isInit {
 		configModified := false
-		if c.ConfigPath() != "" {
-			configModified, err = dirchange.HasDirChecksumChanged(dirCache, configChecksumKey, c.app.Path, c.ConfigPath())
+		if configPaths := c.ConfigPaths(); len(configPaths) > 0 {
+			configModified, err = dirchange.HasDirChecksumChanged(dirCache, configChecksumKey, c.app.Path, configPaths...)
 			if err != nil {
 				return err
 			}
@@ -407,8 +405,8 @@ func (c *Chain) serve(
 	}
 
 	// save checksums
-	if c.ConfigPath() != "" {
-		if err := dirchange.SaveDirChecksum(dirCache, configChecksumKey, c.app.Path, c.ConfigPath()); err != nil {
+	if configPaths := c.ConfigPaths(); len(configPaths) > 0 {
+		if err := dirchange.SaveDirChecksum(dirCache, configChecksumKey, c.app.Path, configPaths...); err != nil {
 			return err
 		}
 	}
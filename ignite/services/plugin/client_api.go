@@ -0,0 +1,162 @@
+package plugin
+
+import (
+	"net/rpc"
+
+	hplugin "github.com/hashicorp/go-plugin"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/events"
+)
+
+// ClientAPI defines the API that plugins can use to call back into ignite.
+// An implementation is given to the plugins each time a command or a hook is
+// executed, and it's only valid during that execution.
+type ClientAPI interface {
+	// GetChainInfo returns the information of the chain ignite is running in.
+	// An error is returned when ignite is not run inside a chain directory.
+	GetChainInfo() (ChainInfo, error)
+	// SendEvent sends an event to the ignite CLI output, for instance to
+	// update the spinner text or to print a message.
+	SendEvent(e events.Event) error
+	// Ask prompts the user the questions and returns the answers indexed by
+	// question name.
+	Ask(questions ...Question) (map[string]string, error)
+}
+
+// ChainInfo holds the information of a chain.
+type ChainInfo struct {
+	// AppPath is the absolute path of the chain's app.
+	AppPath string
+	// ConfigPath is the path of the chain's config file.
+	ConfigPath string
+	// Home is the chain's home directory.
+	Home string
+	// BinaryName is the name of the chain's binary.
+	BinaryName string
+	// ChainID is the chain's ID.
+	ChainID string
+	// Config is the parsed chain's config.
+	Config *chainconfig.Config
+}
+
+// Question represents a question asked to the user.
+type Question struct {
+	// Name identifies the answer of the question.
+	Name string
+	// Text is the text of the question displayed to the user.
+	Text string
+	// DefaultAnswer is the answer used when the user doesn't answer.
+	DefaultAnswer string
+	// Required makes the answer mandatory.
+	Required bool
+	// Hidden hides the answer while the user types it.
+	Hidden bool
+}
+
+// ChainInfoReply is the RPC reply of GetChainInfo.
+// It's exported because net/rpc requires exported reply types.
+// The chain config is sent encoded as YAML because its fields can't be
+// encoded with gob.
+type ChainInfoReply struct {
+	Info   ChainInfo
+	Config []byte
+}
+
+func newChainInfoReply(info ChainInfo) (ChainInfoReply, error) {
+	var r ChainInfoReply
+	if info.Config != nil {
+		bz, err := yaml.Marshal(info.Config)
+		if err != nil {
+			return ChainInfoReply{}, errors.Wrap(err, "encoding chain config")
+		}
+		r.Config = bz
+		info.Config = nil
+	}
+	r.Info = info
+	return r, nil
+}
+
+func (r ChainInfoReply) chainInfo() (ChainInfo, error) {
+	info := r.Info
+	if len(r.Config) > 0 {
+		info.Config = &chainconfig.Config{}
+		if err := yaml.Unmarshal(r.Config, info.Config); err != nil {
+			return ChainInfo{}, errors.Wrap(err, "decoding chain config")
+		}
+	}
+	return info, nil
+}
+
+// ClientAPIRPC is the implementation of ClientAPI that talks over RPC.
+type ClientAPIRPC struct{ client *rpc.Client }
+
+// GetChainInfo implements ClientAPI.GetChainInfo
+func (c *ClientAPIRPC) GetChainInfo() (ChainInfo, error) {
+	var resp ChainInfoReply
+	if err := c.client.Call("Plugin.GetChainInfo", new(interface{}), &resp); err != nil {
+		return ChainInfo{}, err
+	}
+	return resp.chainInfo()
+}
+
+// SendEvent implements ClientAPI.SendEvent
+func (c *ClientAPIRPC) SendEvent(e events.Event) error {
+	var resp interface{}
+	return c.client.Call("Plugin.SendEvent", e, &resp)
+}
+
+// Ask implements ClientAPI.Ask
+func (c *ClientAPIRPC) Ask(questions ...Question) (map[string]string, error) {
+	var resp map[string]string
+	err := c.client.Call("Plugin.Ask", questions, &resp)
+	return resp, err
+}
+
+// ClientAPIRPCServer is the RPC server that ClientAPIRPC talks to.
+type ClientAPIRPCServer struct {
+	// This is the real implementation
+	Impl ClientAPI
+}
+
+func (s *ClientAPIRPCServer) GetChainInfo(args interface{}, resp *ChainInfoReply) error {
+	info, err := s.Impl.GetChainInfo()
+	if err != nil {
+		return err
+	}
+	*resp, err = newChainInfoReply(info)
+	return err
+}
+
+func (s *ClientAPIRPCServer) SendEvent(e events.Event, resp *interface{}) error {
+	return s.Impl.SendEvent(e)
+}
+
+func (s *ClientAPIRPCServer) Ask(questions []Question, resp *map[string]string) error {
+	answers, err := s.Impl.Ask(questions...)
+	*resp = answers
+	return err
+}
+
+// serveClientAPI serves a ClientAPI through the broker and returns the ID
+// of the connection that the plugin must dial to use it.
+// The plugin always dials the connection, even if it doesn't use the API,
+// so the broker never waits for a connection that doesn't come.
+func serveClientAPI(broker *hplugin.MuxBroker, api ClientAPI) uint32 {
+	id := broker.NextId()
+	go broker.AcceptAndServe(id, &ClientAPIRPCServer{Impl: api})
+	return id
+}
+
+// dialClientAPI connects to the ClientAPI served by ignite.
+// The returned function closes the connection.
+func dialClientAPI(broker *hplugin.MuxBroker, id uint32) (ClientAPI, func() error, error) {
+	conn, err := broker.Dial(id)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "connecting to ignite client API")
+	}
+	client := rpc.NewClient(conn)
+	return &ClientAPIRPC{client: client}, client.Close, nil
+}
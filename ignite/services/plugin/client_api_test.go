@@ -0,0 +1,132 @@
+package plugin_test
+
+import (
+	"errors"
+	"testing"
+
+	hplugin "github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/plugin"
+)
+
+// clientAPI is a fake ignite client API.
+type clientAPI struct {
+	info      plugin.ChainInfo
+	infoErr   error
+	events    []events.Event
+	questions []plugin.Question
+}
+
+func (a *clientAPI) GetChainInfo() (plugin.ChainInfo, error) {
+	return a.info, a.infoErr
+}
+
+func (a *clientAPI) SendEvent(e events.Event) error {
+	a.events = append(a.events, e)
+	return nil
+}
+
+func (a *clientAPI) Ask(questions ...plugin.Question) (map[string]string, error) {
+	a.questions = append(a.questions, questions...)
+	answers := make(map[string]string)
+	for _, q := range questions {
+		answers[q.Name] = q.DefaultAnswer
+	}
+	return answers, nil
+}
+
+// apiPlugin is a plugin that calls the client API when a command is executed.
+type apiPlugin struct {
+	info    plugin.ChainInfo
+	answers map[string]string
+}
+
+func (p *apiPlugin) Commands() []plugin.Command { return nil }
+
+func (p *apiPlugin) Hooks() []plugin.Hook { return nil }
+
+func (p *apiPlugin) Execute(_ plugin.Command, _ []string, api plugin.ClientAPI) (err error) {
+	if p.info, err = api.GetChainInfo(); err != nil {
+		return err
+	}
+	if err := api.SendEvent(events.New("executing", events.ProgressStart())); err != nil {
+		return err
+	}
+	p.answers, err = api.Ask(plugin.Question{Name: "name", Text: "Name?", DefaultAnswer: "alice"})
+	return err
+}
+
+func (p *apiPlugin) ExecuteHookPre(plugin.ExecutedHook, plugin.ClientAPI) (plugin.HookResult, error) {
+	return plugin.HookResult{}, nil
+}
+
+func (p *apiPlugin) ExecuteHookPost(plugin.ExecutedHook, plugin.ClientAPI) error { return nil }
+
+func (p *apiPlugin) ExecuteHookCleanUp(_ plugin.ExecutedHook, api plugin.ClientAPI) error {
+	_, err := api.GetChainInfo()
+	return err
+}
+
+func dispensePlugin(t *testing.T, impl plugin.Interface) plugin.Interface {
+	t.Helper()
+
+	client, _ := hplugin.TestPluginRPCConn(t, map[string]hplugin.Plugin{
+		"test": &plugin.InterfacePlugin{Impl: impl},
+	}, nil)
+	t.Cleanup(func() { client.Close() })
+
+	raw, err := client.Dispense("test")
+	require.NoError(t, err)
+	return raw.(plugin.Interface)
+}
+
+func TestClientAPI(t *testing.T) {
+	// Arrange
+	var (
+		impl = &apiPlugin{}
+		p    = dispensePlugin(t, impl)
+		conf = chainconfig.DefaultConfig()
+		api  = &clientAPI{
+			info: plugin.ChainInfo{
+				AppPath:    "/app",
+				ConfigPath: "/app/config.yml",
+				Home:       "/home/.app",
+				BinaryName: "appd",
+				ChainID:    "app",
+				Config:     conf,
+			},
+		}
+	)
+
+	// Act
+	err := p.Execute(plugin.Command{Use: "test"}, nil, api)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, impl.info.Config)
+	require.Equal(t, conf.Version, impl.info.Config.Version)
+	require.Equal(t, conf.Faucet.Port, impl.info.Config.Faucet.Port)
+
+	// The config is compared separately because it's decoded from YAML
+	wantInfo := api.info
+	wantInfo.Config, impl.info.Config = nil, nil
+	require.Equal(t, wantInfo, impl.info)
+	require.Equal(t, []events.Event{events.New("executing", events.ProgressStart())}, api.events)
+	require.Equal(t, []plugin.Question{{Name: "name", Text: "Name?", DefaultAnswer: "alice"}}, api.questions)
+	require.Equal(t, map[string]string{"name": "alice"}, impl.answers)
+}
+
+func TestClientAPIError(t *testing.T) {
+	// Arrange
+	p := dispensePlugin(t, &apiPlugin{})
+	api := &clientAPI{infoErr: errors.New("not a chain")}
+
+	// Act
+	err := p.ExecuteHookCleanUp(plugin.ExecutedHook{Hook: plugin.Hook{Name: "test"}}, api)
+
+	// Assert
+	require.EqualError(t, err, "not a chain")
+}
@@ -0,0 +1,191 @@
+package plugin
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+const (
+	// GlobalConfigFileName is the name of the file that declares the global plugins.
+	GlobalConfigFileName = "plugins.yml"
+
+	configPluginsKey = "plugins"
+)
+
+var (
+	// ErrPluginExists is returned when a plugin is already declared in a config.
+	ErrPluginExists = errors.New("plugin already exists")
+
+	// ErrPluginNotFound is returned when a plugin is not declared in a config.
+	ErrPluginNotFound = errors.New("plugin not found")
+)
+
+// GlobalConfigPath returns the path of the file that declares the global plugins.
+// Global plugins are available to all the ignite commands, even when they are
+// run outside of a chain directory.
+func GlobalConfigPath() (string, error) {
+	dir, err := pluginsPath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, GlobalConfigFileName), nil
+}
+
+// Config keeps the list of plugins declared in a YAML file.
+// The file can either be a chain config.yml or the global plugins file.
+type Config struct {
+	// Plugins holds the declared plugins.
+	Plugins []chainconfig.Plugin `yaml:"plugins"`
+
+	path string
+}
+
+// ParseConfig reads the plugins declared in a YAML file.
+// An empty config is returned when the file doesn't exist.
+func ParseConfig(path string) (*Config, error) {
+	c := &Config{path: path}
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return c, nil
+		}
+		return nil, errors.WithStack(err)
+	}
+	if err := yaml.Unmarshal(bz, c); err != nil {
+		return nil, errors.Wrapf(err, "parsing plugins config %q", path)
+	}
+	return c, nil
+}
+
+// ParseGlobalConfig reads the global plugins file.
+func ParseGlobalConfig() (*Config, error) {
+	path, err := GlobalConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfig(path)
+}
+
+// Path returns the path of the config file.
+func (c *Config) Path() string {
+	return c.path
+}
+
+// LockPath returns the path of the lock file of the config.
+func (c *Config) LockPath() string {
+	return filepath.Join(filepath.Dir(c.path), LockFileName)
+}
+
+// Add declares a new plugin.
+// ErrPluginExists is returned when a plugin with the same path is already declared.
+func (c *Config) Add(p chainconfig.Plugin) error {
+	if c.indexOf(p.Path) != -1 {
+		return errors.Wrapf(ErrPluginExists, "%q in %s", p.Path, c.path)
+	}
+	c.Plugins = append(c.Plugins, p)
+	return nil
+}
+
+// Remove removes a plugin declaration.
+// ErrPluginNotFound is returned when the plugin is not declared.
+func (c *Config) Remove(path string) error {
+	i := c.indexOf(path)
+	if i == -1 {
+		return errors.Wrapf(ErrPluginNotFound, "%q in %s", path, c.path)
+	}
+	c.Plugins = append(c.Plugins[:i], c.Plugins[i+1:]...)
+	return nil
+}
+
+// Save writes the declared plugins into the config file.
+// Only the plugins are updated, the other values of the file and their
+// comments are kept so the config can be saved into a chain config.yml.
+func (c *Config) Save() error {
+	bz, err := os.ReadFile(c.path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.WithStack(err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(bz, &doc); err != nil {
+		return errors.Wrapf(err, "parsing plugins config %q", c.path)
+	}
+	if err := setConfigPlugins(&doc, c.Plugins); err != nil {
+		return errors.Wrapf(err, "updating plugins config %q", c.path)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return errors.WithStack(err)
+	}
+	if err := enc.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(c.path, buf.Bytes(), 0o644))
+}
+
+func (c *Config) indexOf(path string) int {
+	for i, p := range c.Plugins {
+		if p.Path == path {
+			return i
+		}
+	}
+	return -1
+}
+
+// setConfigPlugins replaces the plugins of a YAML document keeping the
+// position and the comments of the existing key. The key is removed when
+// there are no plugins.
+func setConfigPlugins(doc *yaml.Node, plugins []chainconfig.Plugin) error {
+	// Empty files don't have a document node
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		*doc = yaml.Node{
+			Kind:    yaml.DocumentNode,
+			Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}},
+		}
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return errors.New("config is not a YAML mapping")
+	}
+
+	var value yaml.Node
+	if err := value.Encode(plugins); err != nil {
+		return errors.WithStack(err)
+	}
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value != configPluginsKey {
+			continue
+		}
+		if len(plugins) == 0 {
+			root.Content = append(root.Content[:i], root.Content[i+2:]...)
+			return nil
+		}
+		current := root.Content[i+1]
+		value.HeadComment = current.HeadComment
+		value.LineComment = current.LineComment
+		value.FootComment = current.FootComment
+		root.Content[i+1] = &value
+		return nil
+	}
+	if len(plugins) == 0 {
+		return nil
+	}
+	key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: configPluginsKey}
+	root.Content = append(root.Content, key, &value)
+	return nil
+}
@@ -0,0 +1,115 @@
+package plugin_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/services/plugin"
+)
+
+func TestParseConfigNotFound(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "plugins.yml")
+
+	// Act
+	conf, err := plugin.ParseConfig(path)
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, conf.Plugins)
+	require.Equal(t, path, conf.Path())
+}
+
+func TestConfigSave(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "config.yml")
+	err := os.WriteFile(path, []byte(`# Chain config
+version: 1
+accounts:
+  - name: alice # validator account
+    coins:
+      - 1token
+# Declared plugins
+plugins:
+  - path: /local/plugin
+# Faucet config
+faucet:
+  name: alice
+`), 0o644)
+	require.NoError(t, err)
+
+	conf, err := plugin.ParseConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, []chainconfig.Plugin{{Path: "/local/plugin"}}, conf.Plugins)
+
+	// Act
+	err = conf.Add(chainconfig.Plugin{
+		Path: "github.com/ignite/plugin",
+		With: map[string]string{"foo": "bar"},
+	})
+	require.NoError(t, err)
+	err = conf.Remove("/local/plugin")
+	require.NoError(t, err)
+	err = conf.Save()
+
+	// Assert
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, `# Chain config
+version: 1
+accounts:
+  - name: alice # validator account
+    coins:
+      - 1token
+# Declared plugins
+plugins:
+  - path: github.com/ignite/plugin
+    with:
+      foo: bar
+# Faucet config
+faucet:
+  name: alice
+`, string(bz))
+}
+
+func TestConfigSaveWithoutPlugins(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "plugins", "plugins.yml")
+	conf, err := plugin.ParseConfig(path)
+	require.NoError(t, err)
+
+	require.NoError(t, conf.Add(chainconfig.Plugin{Path: "/local/plugin"}))
+	require.NoError(t, conf.Save())
+
+	// Act
+	require.NoError(t, conf.Remove("/local/plugin"))
+	err = conf.Save()
+
+	// Assert
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "{}\n", string(bz))
+}
+
+func TestConfigAddRemoveErrors(t *testing.T) {
+	// Arrange
+	conf, err := plugin.ParseConfig(filepath.Join(t.TempDir(), "plugins.yml"))
+	require.NoError(t, err)
+	require.NoError(t, conf.Add(chainconfig.Plugin{Path: "/local/plugin"}))
+
+	// Act
+	addErr := conf.Add(chainconfig.Plugin{Path: "/local/plugin"})
+	removeErr := conf.Remove("/other/plugin")
+
+	// Assert
+	require.ErrorIs(t, addErr, plugin.ErrPluginExists)
+	require.ErrorIs(t, removeErr, plugin.ErrPluginNotFound)
+}
@@ -0,0 +1,214 @@
+package plugin
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+// FlagType defines the type of a flag value.
+// The types match the names returned by pflag.Value.Type.
+type FlagType string
+
+const (
+	FlagTypeString      FlagType = "string"
+	FlagTypeInt         FlagType = "int"
+	FlagTypeUint        FlagType = "uint"
+	FlagTypeInt64       FlagType = "int64"
+	FlagTypeUint64      FlagType = "uint64"
+	FlagTypeBool        FlagType = "bool"
+	FlagTypeStringSlice FlagType = "stringSlice"
+)
+
+// Flag represents a flag of a command.
+type Flag struct {
+	// Name is the flag name, for instance "output" for `--output`.
+	Name string
+	// Shorthand is the optional one letter flag name, for instance "o" for `-o`.
+	Shorthand string
+	// Usage is the flag description displayed in the command help.
+	Usage string
+	// DefValue is the default value of the flag.
+	// Slice values are separated by commas.
+	DefValue string
+	// Type is the flag value type, FlagTypeString when empty.
+	Type FlagType
+	// Persistent makes the flag available to the sub commands.
+	Persistent bool
+
+	// The following fields are populated at runtime
+
+	// Value holds the parsed flag value.
+	// Slice values are separated by commas.
+	Value string
+}
+
+// AddFlags adds flags to a flag set.
+// Flags with a value are set with it after being added.
+func AddFlags(fs *pflag.FlagSet, flags ...Flag) error {
+	for _, f := range flags {
+		if err := addFlag(fs, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetFlagValues changes the values of existing flags of a flag set.
+// Slice values replace the current ones instead of being appended to them.
+func SetFlagValues(fs *pflag.FlagSet, flags ...Flag) error {
+	for _, f := range flags {
+		pf := fs.Lookup(f.Name)
+		if pf == nil {
+			return errors.Errorf("unknown flag %q", f.Name)
+		}
+		var err error
+		if sv, ok := pf.Value.(pflag.SliceValue); ok {
+			var values []string
+			if f.Value != "" {
+				values = strings.Split(f.Value, ",")
+			}
+			if err = sv.Replace(values); err == nil {
+				pf.Changed = true
+			}
+		} else {
+			err = fs.Set(f.Name, f.Value)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "invalid value for flag %q", f.Name)
+		}
+	}
+	return nil
+}
+
+// FlagsFromFlagSet returns the flags of a flag set with their current values.
+// The help flag and the flags without a value are excluded.
+func FlagsFromFlagSet(fs *pflag.FlagSet) []Flag {
+	var flags []Flag
+	fs.VisitAll(func(pf *pflag.Flag) {
+		if pf.Name == "help" || pf.Value == nil {
+			return
+		}
+		value := pf.Value.String()
+		if sv, ok := pf.Value.(pflag.SliceValue); ok {
+			value = strings.Join(sv.GetSlice(), ",")
+		}
+		flags = append(flags, Flag{
+			Name:      pf.Name,
+			Shorthand: pf.Shorthand,
+			Usage:     pf.Usage,
+			DefValue:  strings.Trim(pf.DefValue, "[]"),
+			Type:      FlagType(pf.Value.Type()),
+			Value:     value,
+		})
+	})
+	return flags
+}
+
+// newFlagSet creates a flag set with the flags and their values.
+// Flags with an unsupported type are added as string flags so their
+// values are always available.
+func newFlagSet(flags []Flag) (*pflag.FlagSet, error) {
+	fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+	for _, f := range flags {
+		if !f.Type.supported() {
+			f.Type = FlagTypeString
+			f.DefValue = ""
+		}
+		if err := addFlag(fs, f); err != nil {
+			return nil, err
+		}
+	}
+	return fs, nil
+}
+
+// CheckFlag checks that a flag can be added to a flag set, its name and its
+// shorthand must not be used by the flags of the set.
+func CheckFlag(fs *pflag.FlagSet, f Flag) error {
+	if len(f.Shorthand) > 1 {
+		return errors.Errorf("shorthand %q of flag %q must be a single character", f.Shorthand, f.Name)
+	}
+	if fs.Lookup(f.Name) != nil {
+		return errors.Errorf("flag %q is already defined", f.Name)
+	}
+	if f.Shorthand == "" {
+		return nil
+	}
+	if other := fs.ShorthandLookup(f.Shorthand); other != nil {
+		return errors.Errorf("shorthand %q of flag %q is already used by flag %q", f.Shorthand, f.Name, other.Name)
+	}
+	return nil
+}
+
+func addFlag(fs *pflag.FlagSet, f Flag) (err error) {
+	// pflag panics when a flag is defined twice
+	if err := CheckFlag(fs, f); err != nil {
+		return err
+	}
+	var (
+		def   = f.DefValue
+		usage = f.Usage
+	)
+	switch f.Type {
+	case FlagTypeString, "":
+		fs.StringP(f.Name, f.Shorthand, def, usage)
+	case FlagTypeBool:
+		var v bool
+		if def != "" {
+			v, err = strconv.ParseBool(def)
+		}
+		fs.BoolP(f.Name, f.Shorthand, v, usage)
+	case FlagTypeInt:
+		var v int64
+		if def != "" {
+			v, err = strconv.ParseInt(def, 10, 0)
+		}
+		fs.IntP(f.Name, f.Shorthand, int(v), usage)
+	case FlagTypeUint:
+		var v uint64
+		if def != "" {
+			v, err = strconv.ParseUint(def, 10, 0)
+		}
+		fs.UintP(f.Name, f.Shorthand, uint(v), usage)
+	case FlagTypeInt64:
+		var v int64
+		if def != "" {
+			v, err = strconv.ParseInt(def, 10, 64)
+		}
+		fs.Int64P(f.Name, f.Shorthand, v, usage)
+	case FlagTypeUint64:
+		var v uint64
+		if def != "" {
+			v, err = strconv.ParseUint(def, 10, 64)
+		}
+		fs.Uint64P(f.Name, f.Shorthand, v, usage)
+	case FlagTypeStringSlice:
+		var v []string
+		if def != "" {
+			v = strings.Split(def, ",")
+		}
+		fs.StringSliceP(f.Name, f.Shorthand, v, usage)
+	default:
+		return errors.Errorf("unsupported type %q for flag %q", f.Type, f.Name)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "invalid default value for flag %q", f.Name)
+	}
+	if f.Value != "" {
+		if err := fs.Set(f.Name, f.Value); err != nil {
+			return errors.Wrapf(err, "invalid value for flag %q", f.Name)
+		}
+	}
+	return nil
+}
+
+func (t FlagType) supported() bool {
+	switch t {
+	case FlagTypeString, FlagTypeInt, FlagTypeUint, FlagTypeInt64,
+		FlagTypeUint64, FlagTypeBool, FlagTypeStringSlice, "":
+		return true
+	}
+	return false
+}
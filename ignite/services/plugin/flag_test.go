@@ -0,0 +1,144 @@
+package plugin_test
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/plugin"
+)
+
+func TestAddFlags(t *testing.T) {
+	// Arrange
+	fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+
+	// Act
+	err := plugin.AddFlags(fs,
+		plugin.Flag{Name: "name", Shorthand: "n", Usage: "name", DefValue: "foo"},
+		plugin.Flag{Name: "count", Type: plugin.FlagTypeInt, DefValue: "2"},
+		plugin.Flag{Name: "force", Type: plugin.FlagTypeBool},
+		plugin.Flag{Name: "tags", Type: plugin.FlagTypeStringSlice, DefValue: "a,b"},
+	)
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, fs.Parse([]string{"-n", "bar", "--force", "--tags", "c,d"}))
+	name, _ := fs.GetString("name")
+	require.Equal(t, "bar", name)
+	count, _ := fs.GetInt("count")
+	require.Equal(t, 2, count)
+	force, _ := fs.GetBool("force")
+	require.True(t, force)
+	tags, _ := fs.GetStringSlice("tags")
+	require.Equal(t, []string{"c", "d"}, tags)
+}
+
+func TestAddFlagsError(t *testing.T) {
+	tests := []struct {
+		name          string
+		flag          plugin.Flag
+		expectedError string
+	}{
+		{
+			name:          "fail: unsupported type",
+			flag:          plugin.Flag{Name: "timeout", Type: "duration"},
+			expectedError: `unsupported type "duration" for flag "timeout"`,
+		},
+		{
+			name:          "fail: invalid default value",
+			flag:          plugin.Flag{Name: "count", Type: plugin.FlagTypeInt, DefValue: "a"},
+			expectedError: `invalid default value for flag "count": strconv.ParseInt: parsing "a": invalid syntax`,
+		},
+		{
+			name:          "fail: flag already defined",
+			flag:          plugin.Flag{Name: "name"},
+			expectedError: `flag "name" is already defined`,
+		},
+		{
+			name:          "fail: shorthand already used",
+			flag:          plugin.Flag{Name: "number", Shorthand: "n"},
+			expectedError: `shorthand "n" of flag "number" is already used by flag "name"`,
+		},
+		{
+			name:          "fail: shorthand with several characters",
+			flag:          plugin.Flag{Name: "number", Shorthand: "nb"},
+			expectedError: `shorthand "nb" of flag "number" must be a single character`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+			fs.StringP("name", "n", "", "name")
+
+			err := plugin.AddFlags(fs, tt.flag)
+
+			require.EqualError(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestFlagsFromFlagSet(t *testing.T) {
+	// Arrange
+	fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+	fs.BoolP("help", "h", false, "help")
+	fs.Uint64("gas", 10, "gas")
+	fs.StringSlice("tags", []string{"a"}, "tags")
+	require.NoError(t, fs.Parse([]string{"--gas", "20", "--tags", "b,c"}))
+
+	// Act
+	flags := plugin.FlagsFromFlagSet(fs)
+
+	// Assert
+	require.Equal(t, []plugin.Flag{
+		{Name: "gas", Usage: "gas", DefValue: "10", Type: plugin.FlagTypeUint64, Value: "20"},
+		{Name: "tags", Usage: "tags", DefValue: "a", Type: plugin.FlagTypeStringSlice, Value: "b,c"},
+	}, flags)
+}
+
+func TestCommandFlagSet(t *testing.T) {
+	// Arrange
+	cmd := plugin.Command{
+		Flags: []plugin.Flag{
+			{Name: "count", Type: plugin.FlagTypeInt64, DefValue: "1", Value: "3"},
+			{Name: "tags", Type: plugin.FlagTypeStringSlice, Value: "a,b"},
+			{Name: "timeout", Type: "duration", DefValue: "1s", Value: "5s"},
+		},
+	}
+
+	// Act
+	fs, err := cmd.FlagSet()
+
+	// Assert
+	require.NoError(t, err)
+	count, _ := fs.GetInt64("count")
+	require.EqualValues(t, 3, count)
+	tags, _ := fs.GetStringSlice("tags")
+	require.Equal(t, []string{"a", "b"}, tags)
+	// Values of unsupported types are available as strings
+	timeout, _ := fs.GetString("timeout")
+	require.Equal(t, "5s", timeout)
+}
+
+func TestSetFlagValues(t *testing.T) {
+	// Arrange
+	fs := pflag.NewFlagSet("", pflag.ContinueOnError)
+	fs.String("name", "foo", "name")
+	fs.StringSlice("tags", nil, "tags")
+	require.NoError(t, fs.Parse([]string{"--tags", "a"}))
+
+	// Act
+	err := plugin.SetFlagValues(fs,
+		plugin.Flag{Name: "name", Value: "bar"},
+		plugin.Flag{Name: "tags", Value: "b,c"},
+	)
+
+	// Assert
+	require.NoError(t, err)
+	name, _ := fs.GetString("name")
+	require.Equal(t, "bar", name)
+	tags, _ := fs.GetStringSlice("tags")
+	require.Equal(t, []string{"b", "c"}, tags)
+	require.True(t, fs.Changed("name"))
+	require.EqualError(t, plugin.SetFlagValues(fs, plugin.Flag{Name: "unknown"}), `unknown flag "unknown"`)
+}
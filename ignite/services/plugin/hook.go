@@ -0,0 +1,49 @@
+package plugin
+
+// ExecutedHook is the context given to a plugin when one of its hooks is
+// executed.
+type ExecutedHook struct {
+	// Hook is the executed hook.
+	// Its flags are the flags of the command the hook is attached to, with
+	// their parsed values.
+	Hook
+	// CommandPath is the full path of the command the hook is attached to,
+	// for instance "ignite chain serve".
+	CommandPath string
+	// Args holds the command positional arguments.
+	Args []string
+	// WorkingDir is the directory ignite is executed in.
+	WorkingDir string
+	// Chain holds the information of the chain ignite is executed in.
+	// It's nil when ignite is not executed inside a chain directory.
+	Chain *ChainInfo
+	// ExecuteError is the error message of the command execution.
+	// It's only defined for post and clean up hooks, and it's empty when the
+	// command succeeds.
+	ExecuteError string
+}
+
+// HookDecision defines how the command execution continues after a pre hook.
+type HookDecision int
+
+const (
+	// HookContinue continues the command execution.
+	HookContinue HookDecision = iota
+	// HookSkip skips the command execution without failing.
+	HookSkip
+	// HookAbort stops the command execution with an error.
+	HookAbort
+)
+
+// HookResult is the result of a pre hook execution.
+type HookResult struct {
+	// Decision defines how the command execution continues.
+	Decision HookDecision
+	// Reason explains to the user why the command is skipped or aborted.
+	Reason string
+	// Args replaces the command positional arguments when it's not nil.
+	Args []string
+	// Flags changes the values of the command flags.
+	// Only the Name and Value fields of the flags are used.
+	Flags []Flag
+}
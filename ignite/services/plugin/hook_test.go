@@ -0,0 +1,99 @@
+package plugin_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/services/plugin"
+)
+
+// hookPlugin is a plugin that records the executed hooks.
+type hookPlugin struct {
+	apiPlugin
+
+	result plugin.HookResult
+	hooks  []plugin.ExecutedHook
+}
+
+func (p *hookPlugin) ExecuteHookPre(hook plugin.ExecutedHook, _ plugin.ClientAPI) (plugin.HookResult, error) {
+	p.hooks = append(p.hooks, hook)
+	return p.result, nil
+}
+
+func (p *hookPlugin) ExecuteHookPost(hook plugin.ExecutedHook, _ plugin.ClientAPI) error {
+	p.hooks = append(p.hooks, hook)
+	return nil
+}
+
+func TestExecuteHook(t *testing.T) {
+	// Arrange
+	var (
+		impl = &hookPlugin{
+			result: plugin.HookResult{
+				Decision: plugin.HookSkip,
+				Reason:   "nothing to do",
+				Args:     []string{"bar"},
+				Flags:    []plugin.Flag{{Name: "home", Value: "/home"}},
+			},
+		}
+		p    = dispensePlugin(t, impl)
+		conf = chainconfig.DefaultConfig()
+		hook = plugin.ExecutedHook{
+			Hook: plugin.Hook{
+				Name:        "test",
+				PlaceHookOn: "chain serve",
+				Flags:       []plugin.Flag{{Name: "home", Value: "/app"}},
+			},
+			CommandPath: "ignite chain serve",
+			Args:        []string{"foo"},
+			WorkingDir:  "/app",
+			Chain: &plugin.ChainInfo{
+				AppPath: "/app",
+				ChainID: "app",
+				Config:  conf,
+			},
+		}
+	)
+
+	// Act
+	result, err := p.ExecuteHookPre(hook, &clientAPI{})
+	require.NoError(t, err)
+	hook.ExecuteError = "oops"
+	err = p.ExecuteHookPost(hook, &clientAPI{})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, impl.result, result)
+	require.Len(t, impl.hooks, 2)
+	for i, h := range impl.hooks {
+		require.NotNil(t, h.Chain)
+		require.NotNil(t, h.Chain.Config)
+		require.Equal(t, conf.Version, h.Chain.Config.Version)
+
+		// The config is compared separately because it's decoded from YAML
+		want := hook
+		wantChain := *hook.Chain
+		wantChain.Config, h.Chain.Config = nil, nil
+		want.Chain = &wantChain
+		if i == 0 {
+			want.ExecuteError = ""
+		}
+		require.Equal(t, want, h)
+	}
+}
+
+func TestExecuteHookWithoutChain(t *testing.T) {
+	// Arrange
+	impl := &hookPlugin{}
+	p := dispensePlugin(t, impl)
+
+	// Act
+	result, err := p.ExecuteHookPre(plugin.ExecutedHook{CommandPath: "ignite scaffold chain"}, &clientAPI{})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, plugin.HookResult{}, result)
+	require.Equal(t, []plugin.ExecutedHook{{CommandPath: "ignite scaffold chain"}}, impl.hooks)
+}
@@ -7,11 +7,15 @@ import (
 
 	"github.com/hashicorp/go-plugin"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 func init() {
 	gob.Register(Command{})
 	gob.Register(Hook{})
+	gob.Register(Flag{})
+	gob.Register(ExecutedHook{})
+	gob.Register(ChainInfoReply{})
 }
 
 // An ignite plugin must implements the Plugin interface.
@@ -25,20 +29,25 @@ type Interface interface {
 	// Execute will be invoked by ignite when a plugin commands is executed.
 	// cmd is the executed command (one of the those returned by Commands method)
 	// args is the command line arguments passed behing the command.
-	Execute(cmd Command, args []string) error
+	// api allows the plugin to call back into ignite during the execution.
+	Execute(cmd Command, args []string, api ClientAPI) error
 	// Hooks defines custom hooks registered with a given plugin
 	Hooks() []Hook
-	// ExecuteHookPre is invoked by Ignite when a command specified by the hook
-	// path is invoked is global for all hooks registered to a plugin context on
-	// the hook being invoked is given by the `hook` parameter.
-	ExecuteHookPre(hook Hook, args []string) error
-	// ExecuteHookPost is invoked by Ignite when a command specified by the hook
-	// path is invoked is global for all hooks registered to a plugin context on
-	// the hook being invoked is given by the `hook` parameter.
-	ExecuteHookPost(hook Hook, args []string) error
+	// ExecuteHookPre is invoked by Ignite before a command specified by the hook
+	// path is executed. It is global for all hooks registered to a plugin,
+	// the context of the hook being invoked is given by the `hook` parameter.
+	// The returned result can change the command arguments and flags, or skip
+	// or abort the command execution.
+	ExecuteHookPre(hook ExecutedHook, api ClientAPI) (HookResult, error)
+	// ExecuteHookPost is invoked by Ignite after a command specified by the hook
+	// path is executed, even when the command fails, in which case the error is
+	// given by the hook.ExecuteError field. It is global for all hooks
+	// registered to a plugin, the context of the hook being invoked is given by
+	// the `hook` parameter.
+	ExecuteHookPost(hook ExecutedHook, api ClientAPI) error
 	// ExecuteHookCleanUp is invoked right before the command is done executing
 	// will be called regardless of execution status of the command and hooks.
-	ExecuteHookCleanUp(hook Hook, args []string) error
+	ExecuteHookCleanUp(hook ExecutedHook, api ClientAPI) error
 }
 
 // Command represents a plugin command.
@@ -56,6 +65,11 @@ type Command struct {
 	PlaceCommandUnder string
 	// List of sub commands
 	Commands []Command
+	// Flags holds the list of command flags.
+	// When the command is executed the list contains all the command flags,
+	// including the persistent flags of the parent commands, with their
+	// parsed values.
+	Flags []Flag
 
 	// The following fields are populated at runtime
 	CobraCmd *cobra.Command
@@ -72,14 +86,33 @@ type Hook struct {
 
 	// commands to register the hooks for
 	PlaceHookOn string
+
+	// The following fields are populated at runtime
+
+	// Flags holds the flags of the command the hook is attached to,
+	// with their parsed values.
+	Flags []Flag
+}
+
+// FlagSet returns a flag set with the command flags and their parsed values.
+func (c Command) FlagSet() (*pflag.FlagSet, error) {
+	return newFlagSet(c.Flags)
+}
+
+// FlagSet returns a flag set with the flags of the command the hook is
+// attached to and their parsed values.
+func (h Hook) FlagSet() (*pflag.FlagSet, error) {
+	return newFlagSet(h.Flags)
 }
 
 // handshakeConfigs are used to just do a basic handshake between
 // a plugin and host. If the handshake fails, a user friendly error is shown.
 // This prevents users from executing bad plugins or executing a plugin
 // directory. It is a UX feature, not a security feature.
+// The protocol version must be increased each time the plugin interface
+// changes in a way that is not compatible with the existing plugins.
 var handshakeConfig = plugin.HandshakeConfig{
-	ProtocolVersion:  1,
+	ProtocolVersion:  3,
 	MagicCookieKey:   "BASIC_PLUGIN",
 	MagicCookieValue: "hello",
 }
@@ -89,7 +122,10 @@ func HandshakeConfig() plugin.HandshakeConfig {
 }
 
 // Here is an implementation that talks over RPC
-type InterfaceRPC struct{ client *rpc.Client }
+type InterfaceRPC struct {
+	client *rpc.Client
+	broker *plugin.MuxBroker
+}
 
 // Commands implements Interface.Commands
 func (g *InterfaceRPC) Commands() []Command {
@@ -116,36 +152,46 @@ func (g *InterfaceRPC) Hooks() []Hook {
 }
 
 // Execute implements Interface.Commands
-func (g *InterfaceRPC) Execute(c Command, args []string) error {
+func (g *InterfaceRPC) Execute(c Command, args []string, api ClientAPI) error {
 	var resp interface{}
 	return g.client.Call("Plugin.Execute", map[string]interface{}{
 		"command": c,
 		"args":    args,
+		"api":     serveClientAPI(g.broker, api),
 	}, &resp)
 }
 
-func (g *InterfaceRPC) ExecuteHookPre(hook Hook, args []string) error {
-	var resp interface{}
-	return g.client.Call("Plugin.ExecuteHookPre", map[string]interface{}{
-		"hook": hook,
-		"args": args,
-	}, &resp)
+func (g *InterfaceRPC) ExecuteHookPre(hook ExecutedHook, api ClientAPI) (HookResult, error) {
+	var resp HookResult
+	err := g.executeHook("Plugin.ExecuteHookPre", hook, api, &resp)
+	return resp, err
 }
 
-func (g *InterfaceRPC) ExecuteHookPost(hook Hook, args []string) error {
+func (g *InterfaceRPC) ExecuteHookPost(hook ExecutedHook, api ClientAPI) error {
 	var resp interface{}
-	return g.client.Call("Plugin.ExecuteHookPost", map[string]interface{}{
-		"hook": hook,
-		"args": args,
-	}, &resp)
+	return g.executeHook("Plugin.ExecuteHookPost", hook, api, &resp)
 }
 
-func (g *InterfaceRPC) ExecuteHookCleanUp(hook Hook, args []string) error {
+func (g *InterfaceRPC) ExecuteHookCleanUp(hook ExecutedHook, api ClientAPI) error {
 	var resp interface{}
-	return g.client.Call("Plugin.ExecuteHookCleanUp", map[string]interface{}{
-		"hook": hook,
-		"args": args,
-	}, &resp)
+	return g.executeHook("Plugin.ExecuteHookCleanUp", hook, api, &resp)
+}
+
+func (g *InterfaceRPC) executeHook(method string, hook ExecutedHook, api ClientAPI, resp interface{}) error {
+	args := map[string]interface{}{}
+	if hook.Chain != nil {
+		// The chain info is sent separately because its config can't be
+		// encoded with gob.
+		chain, err := newChainInfoReply(*hook.Chain)
+		if err != nil {
+			return err
+		}
+		args["chain"] = chain
+		hook.Chain = nil
+	}
+	args["hook"] = hook
+	args["api"] = serveClientAPI(g.broker, api)
+	return g.client.Call(method, args, resp)
 }
 
 // Here is the RPC server that InterfaceRPC talks to, conforming to
@@ -153,6 +199,8 @@ func (g *InterfaceRPC) ExecuteHookCleanUp(hook Hook, args []string) error {
 type InterfaceRPCServer struct {
 	// This is the real implementation
 	Impl Interface
+
+	broker *plugin.MuxBroker
 }
 
 func (s *InterfaceRPCServer) Commands(args interface{}, resp *[]Command) error {
@@ -166,19 +214,45 @@ func (s *InterfaceRPCServer) Hooks(args interface{}, resp *[]Hook) error {
 }
 
 func (s *InterfaceRPCServer) Execute(args map[string]interface{}, resp *interface{}) error {
-	return s.Impl.Execute(args["command"].(Command), args["args"].([]string))
+	api, closeAPI, err := dialClientAPI(s.broker, args["api"].(uint32))
+	if err != nil {
+		return err
+	}
+	defer closeAPI()
+	return s.Impl.Execute(args["command"].(Command), args["args"].([]string), api)
 }
 
-func (s *InterfaceRPCServer) ExecuteHookPre(args map[string]interface{}, resp *interface{}) error {
-	return s.Impl.ExecuteHookPre(args["hook"].(Hook), args["args"].([]string))
+func (s *InterfaceRPCServer) ExecuteHookPre(args map[string]interface{}, resp *HookResult) error {
+	return s.executeHook(args, func(hook ExecutedHook, api ClientAPI) (err error) {
+		*resp, err = s.Impl.ExecuteHookPre(hook, api)
+		return err
+	})
 }
 
 func (s *InterfaceRPCServer) ExecuteHookPost(args map[string]interface{}, resp *interface{}) error {
-	return s.Impl.ExecuteHookPost(args["hook"].(Hook), args["args"].([]string))
+	return s.executeHook(args, s.Impl.ExecuteHookPost)
 }
 
 func (s *InterfaceRPCServer) ExecuteHookCleanUp(args map[string]interface{}, resp *interface{}) error {
-	return s.Impl.ExecuteHookCleanUp(args["hook"].(Hook), args["args"].([]string))
+	return s.executeHook(args, s.Impl.ExecuteHookCleanUp)
+}
+
+func (s *InterfaceRPCServer) executeHook(args map[string]interface{}, call func(ExecutedHook, ClientAPI) error) error {
+	api, closeAPI, err := dialClientAPI(s.broker, args["api"].(uint32))
+	if err != nil {
+		return err
+	}
+	defer closeAPI()
+
+	hook := args["hook"].(ExecutedHook)
+	if chain, ok := args["chain"].(ChainInfoReply); ok {
+		info, err := chain.chainInfo()
+		if err != nil {
+			return err
+		}
+		hook.Chain = &info
+	}
+	return call(hook, api)
 }
 
 // This is the implementation of plugin.Interface so we can serve/consume this
@@ -189,17 +263,17 @@ func (s *InterfaceRPCServer) ExecuteHookCleanUp(args map[string]interface{}, res
 // Client must return an implementation of our interface that communicates
 // over an RPC client. We return InterfaceRPC for this.
 //
-// Ignore MuxBroker. That is used to create more multiplexed streams on our
-// plugin connection and is a more advanced use case.
+// The MuxBroker is used to serve the ClientAPI from ignite to the plugin
+// on a separate multiplexed stream of the plugin connection.
 type InterfacePlugin struct {
 	// Impl Injection
 	Impl Interface
 }
 
-func (p *InterfacePlugin) Server(*plugin.MuxBroker) (interface{}, error) {
-	return &InterfaceRPCServer{Impl: p.Impl}, nil
+func (p *InterfacePlugin) Server(b *plugin.MuxBroker) (interface{}, error) {
+	return &InterfaceRPCServer{Impl: p.Impl, broker: b}, nil
 }
 
 func (InterfacePlugin) Client(b *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
-	return &InterfaceRPC{client: c}, nil
+	return &InterfaceRPC{client: c, broker: b}, nil
 }
@@ -0,0 +1,129 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// LockFileName is the name of the file that locks the versions of the plugins
+	// declared in a config. The lock file is located next to the config file.
+	LockFileName = "plugins.lock"
+
+	lockFileHeader = "# This file is generated by ignite, do not edit.\n"
+)
+
+// Lock keeps the resolved versions of remote plugins so they are installed
+// the same way on every machine.
+type Lock struct {
+	// Plugins holds the locked plugins.
+	Plugins []LockedPlugin `yaml:"plugins"`
+
+	path string
+}
+
+// LockedPlugin is the resolved version of a remote plugin.
+type LockedPlugin struct {
+	// Path is the plugin path as declared in the config.
+	Path string `yaml:"path"`
+	// Commit is the hash of the commit the plugin binary is built from.
+	Commit string `yaml:"commit"`
+	// Checksums are the SHA256 checksums of the plugin binary by build platform.
+	// The binaries built from the same commit differ between OS, architectures
+	// and Go versions, so the platforms are formatted as "GOOS/GOARCH/GOVERSION".
+	Checksums map[string]string `yaml:"checksums"`
+}
+
+// withChecksum returns a copy of the locked plugin with the checksum of the binary
+// built for platform.
+func (p LockedPlugin) withChecksum(platform, checksum string) LockedPlugin {
+	checksums := make(map[string]string, len(p.Checksums)+1)
+	for k, v := range p.Checksums {
+		checksums[k] = v
+	}
+	checksums[platform] = checksum
+	p.Checksums = checksums
+	return p
+}
+
+// ParseLock reads a plugins lock file.
+// An empty lock is returned when the file doesn't exist.
+func ParseLock(path string) (*Lock, error) {
+	l := &Lock{path: path}
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return l, nil
+		}
+		return nil, errors.WithStack(err)
+	}
+	if err := yaml.Unmarshal(bz, l); err != nil {
+		return nil, errors.Wrapf(err, "parsing plugins lock %q", path)
+	}
+	return l, nil
+}
+
+// Path returns the path of the lock file.
+func (l *Lock) Path() string {
+	return l.path
+}
+
+// Get returns the locked version of a plugin.
+func (l *Lock) Get(path string) (LockedPlugin, bool) {
+	if i := l.indexOf(path); i != -1 {
+		return l.Plugins[i], true
+	}
+	return LockedPlugin{}, false
+}
+
+// Set locks the version of a plugin, replacing the existing one if any.
+func (l *Lock) Set(p LockedPlugin) {
+	if i := l.indexOf(p.Path); i != -1 {
+		l.Plugins[i] = p
+		return
+	}
+	l.Plugins = append(l.Plugins, p)
+}
+
+// Remove removes the locked version of a plugin.
+// It returns false when the plugin is not locked.
+func (l *Lock) Remove(path string) bool {
+	i := l.indexOf(path)
+	if i == -1 {
+		return false
+	}
+	l.Plugins = append(l.Plugins[:i], l.Plugins[i+1:]...)
+	return true
+}
+
+// Save writes the lock file.
+// The file is removed when there are no locked plugins.
+func (l *Lock) Save() error {
+	if len(l.Plugins) == 0 {
+		if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+			return errors.WithStack(err)
+		}
+		return nil
+	}
+	bz, err := yaml.Marshal(l)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+		return errors.WithStack(err)
+	}
+	bz = append([]byte(lockFileHeader), bz...)
+	return errors.WithStack(os.WriteFile(l.path, bz, 0o644))
+}
+
+func (l *Lock) indexOf(path string) int {
+	for i, p := range l.Plugins {
+		if p.Path == path {
+			return i
+		}
+	}
+	return -1
+}
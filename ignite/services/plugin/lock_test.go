@@ -0,0 +1,87 @@
+package plugin_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/plugin"
+)
+
+func TestParseLockNotFound(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), plugin.LockFileName)
+
+	// Act
+	lock, err := plugin.ParseLock(path)
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, lock.Plugins)
+	require.Equal(t, path, lock.Path())
+}
+
+func TestLockSave(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), plugin.LockFileName)
+	lock, err := plugin.ParseLock(path)
+	require.NoError(t, err)
+
+	lock.Set(plugin.LockedPlugin{Path: "github.com/ignite/foo", Commit: "a1", Checksums: map[string]string{"linux/amd64/go1.19": "c1"}})
+	lock.Set(plugin.LockedPlugin{Path: "github.com/ignite/bar", Commit: "a2", Checksums: map[string]string{"linux/amd64/go1.19": "c2"}})
+	lock.Set(plugin.LockedPlugin{Path: "github.com/ignite/foo", Commit: "a3", Checksums: map[string]string{"linux/amd64/go1.19": "c3"}})
+	require.True(t, lock.Remove("github.com/ignite/bar"))
+	require.False(t, lock.Remove("github.com/ignite/baz"))
+
+	// Act
+	err = lock.Save()
+
+	// Assert
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, `# This file is generated by ignite, do not edit.
+plugins:
+- path: github.com/ignite/foo
+  commit: a3
+  checksums:
+    linux/amd64/go1.19: c3
+`, string(bz))
+
+	lock, err = plugin.ParseLock(path)
+	require.NoError(t, err)
+	locked, ok := lock.Get("github.com/ignite/foo")
+	require.True(t, ok)
+	require.Equal(t, plugin.LockedPlugin{Path: "github.com/ignite/foo", Commit: "a3", Checksums: map[string]string{"linux/amd64/go1.19": "c3"}}, locked)
+	_, ok = lock.Get("github.com/ignite/bar")
+	require.False(t, ok)
+}
+
+func TestLockSaveWithoutPlugins(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), plugin.LockFileName)
+	lock, err := plugin.ParseLock(path)
+	require.NoError(t, err)
+
+	lock.Set(plugin.LockedPlugin{Path: "github.com/ignite/foo"})
+	require.NoError(t, lock.Save())
+	require.FileExists(t, path)
+
+	// Act
+	lock.Remove("github.com/ignite/foo")
+	err = lock.Save()
+
+	// Assert
+	require.NoError(t, err)
+	require.NoFileExists(t, path)
+}
+
+func TestConfigLockPath(t *testing.T) {
+	conf, err := plugin.ParseConfig(filepath.Join("chain", "config.yml"))
+	require.NoError(t, err)
+
+	require.Equal(t, filepath.Join("chain", plugin.LockFileName), conf.LockPath())
+}
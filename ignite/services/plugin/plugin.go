@@ -11,6 +11,8 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,12 +21,13 @@ import (
 	"github.com/hashicorp/go-hclog"
 	hplugin "github.com/hashicorp/go-plugin"
 	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 
 	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/checksum"
 	"github.com/ignite/cli/ignite/pkg/cliui"
 	"github.com/ignite/cli/ignite/pkg/gocmd"
 	"github.com/ignite/cli/ignite/pkg/xfilepath"
-	"github.com/ignite/cli/ignite/services/chain"
 )
 
 // pluginsPath holds the plugin cache directory.
@@ -33,6 +36,26 @@ var pluginsPath = xfilepath.Join(
 	xfilepath.Path("plugins"),
 )
 
+// incompatibleVersionRe matches the go-plugin error returned when the
+// handshake protocol versions of ignite and a plugin are different.
+var incompatibleVersionRe = regexp.MustCompile(`Incompatible API version with plugin\. Plugin version: (\d+)`)
+
+// ProtocolVersionError is returned when a plugin is built with a protocol
+// version that is not compatible with the one of ignite.
+type ProtocolVersionError struct {
+	// Version is the protocol version of the plugin.
+	Version int
+}
+
+func (e ProtocolVersionError) Error() string {
+	return fmt.Sprintf(
+		"plugin protocol version %d is not compatible with ignite protocol version %d, "+
+			"the plugin must be upgraded to a version that supports this ignite version",
+		e.Version,
+		handshakeConfig.ProtocolVersion,
+	)
+}
+
 // Plugin represents a ignite plugin.
 type Plugin struct {
 	// Embed the plugin configuration
@@ -41,6 +64,8 @@ type Plugin struct {
 	Interface Interface
 	// If any error occurred during the plugin load, it's stored here
 	Error error
+	// Global is true when the plugin is declared in the global plugins config.
+	Global bool
 
 	repoPath   string
 	cloneURL   string
@@ -49,10 +74,26 @@ type Plugin struct {
 	srcPath    string
 	binaryName string
 
+	// manifest and archivePath are defined for prebuilt plugins.
+	manifest    *Manifest
+	archivePath string
+
+	// lock keeps the resolved versions of the plugins of the config the
+	// plugin is declared in.
+	lock *Lock
+	// commit and checksum are the resolved version of a remote plugin,
+	// platform is the build platform of the checksum.
+	commit   string
+	checksum string
+	platform string
+
 	client *hplugin.Client
 }
 
-// Load loads the plugins found in the chain config.
+// Load loads the plugins found in the chain config and in the global plugins
+// config. Any of the two configs can be nil, for instance when ignite is run
+// outside of a chain directory. When a plugin is declared in both configs the
+// declaration of the chain config is used.
 //
 // There's 2 kinds of plugins, local or remote.
 // Local plugins have their path starting with a `/`, while remote plugins
@@ -61,35 +102,106 @@ type Plugin struct {
 // Remote plugins require to be fetched first, in $HOME/.ignite/plugins
 // folder, then they are loaded from there.
 //
+// Local plugins can also be prebuilt, in which case their path is either a
+// tarball (.tar.gz or .tgz) or a directory which contains a manifest.yml file
+// and the plugin binary. Prebuilt plugins are loaded without being built, so
+// they can be installed offline and without a Go toolchain.
+//
 // If an error occurs during a plugin load, it's not returned but rather stored
 // in the Plugin.Error field. This prevents the loading of other plugins to be
 // interrupted.
-func Load(ctx context.Context, c *chain.Chain) ([]*Plugin, error) {
-	conf, err := c.Config()
-	if err != nil {
-		return nil, errors.WithStack(err)
-	}
+//
+// The versions of the remote plugins are verified against the lock file of
+// the config they are declared in. The remote plugins that aren't locked yet
+// are added to the lock once loaded, but the existing versions are only
+// changed by Update. The binary checksums are locked by build platform, the
+// checksum of a platform is added to the lock the first time the plugin is
+// built for it.
+func Load(ctx context.Context, chainConf, globalConf *Config) ([]*Plugin, error) {
 	pluginsDir, err := pluginsPath()
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
-	var plugins []*Plugin
-	for _, cp := range conf.Plugins {
-		p := newPlugin(pluginsDir, cp)
-		p.load(ctx)
-		plugins = append(plugins, p)
+	var (
+		plugins  []*Plugin
+		declared = make(map[string]bool)
+	)
+	for _, conf := range []*Config{chainConf, globalConf} {
+		if conf == nil {
+			continue
+		}
+		lock, err := ParseLock(conf.LockPath())
+		if err != nil {
+			return nil, err
+		}
+		var lockChanged bool
+		for _, cp := range conf.Plugins {
+			if declared[cp.Path] {
+				continue
+			}
+			declared[cp.Path] = true
+			p := newPlugin(pluginsDir, cp)
+			p.Global = conf == globalConf
+			p.lock = lock
+			p.load(ctx)
+			plugins = append(plugins, p)
+
+			if p.Error != nil || p.isLocal() {
+				continue
+			}
+			locked, ok := lock.Get(p.Path)
+			if !ok {
+				lock.Set(p.lockedPlugin())
+				lockChanged = true
+			} else if _, ok := locked.Checksums[p.platform]; !ok {
+				// Lock the checksum of the binary built for a new platform
+				lock.Set(locked.withChecksum(p.platform, p.checksum))
+				lockChanged = true
+			}
+		}
+		if lockChanged {
+			if err := lock.Save(); err != nil {
+				return nil, err
+			}
+		}
 	}
 	return plugins, nil
 }
 
-// Update removes the cache directory of plugins and fetch them again.
-func Update(plugins ...*Plugin) error {
+// Update fetches and builds the remote plugins again from their references
+// and locks the resolved versions.
+// This is the only way to change the locked version of a plugin.
+func Update(ctx context.Context, plugins ...*Plugin) error {
+	pluginsDir, err := pluginsPath()
+	if err != nil {
+		return errors.WithStack(err)
+	}
 	for _, p := range plugins {
-		err := p.clean()
-		if err != nil {
+		// Use a new plugin to ignore the load errors, which can be caused by
+		// the plugin version that is updated.
+		up := newPlugin(pluginsDir, p.Plugin)
+		if up.Error != nil {
+			return up.Error
+		}
+		if up.isLocal() {
+			continue
+		}
+		if err := up.clean(); err != nil {
 			return err
 		}
-		p.fetch()
+		up.fetch()
+		up.build(ctx)
+		up.resolveBinary(ctx)
+		up.checkLock()
+		if up.Error != nil {
+			return up.Error
+		}
+		if p.lock != nil {
+			p.lock.Set(up.lockedPlugin())
+			if err := p.lock.Save(); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -112,11 +224,25 @@ func newPlugin(pluginsDir string, cp chainconfig.Plugin) *Plugin {
 			return p
 		}
 		if !st.IsDir() {
+			if isArchive(pluginPath) {
+				p.setArchive(pluginsDir, pluginPath)
+				return p
+			}
 			p.Error = errors.Errorf("local plugin path %q is not a dir", pluginPath)
 			return p
 		}
 		p.srcPath = pluginPath
 		p.binaryName = path.Base(pluginPath)
+		m, ok, err := readDirManifest(pluginPath)
+		if err != nil {
+			p.Error = errors.Wrapf(err, "local plugin %q", pluginPath)
+			return p
+		}
+		if ok {
+			// This is a prebuilt plugin
+			p.manifest = &m
+			p.binaryName = m.Name
+		}
 		return p
 	}
 	// This is a remote plugin, parse the URL
@@ -141,6 +267,20 @@ func newPlugin(pluginsDir string, cp chainconfig.Plugin) *Plugin {
 	return p
 }
 
+// setArchive configures a prebuilt plugin from a tarball, which is unpacked
+// in the plugins cache directory.
+func (p *Plugin) setArchive(pluginsDir, archivePath string) {
+	m, err := readArchiveManifest(archivePath)
+	if err != nil {
+		p.Error = errors.Wrapf(err, "local plugin archive %q", archivePath)
+		return
+	}
+	p.manifest = &m
+	p.archivePath = archivePath
+	p.binaryName = m.Name
+	p.srcPath = path.Join(pluginsDir, archivesDir, m.Name+"@"+m.Version)
+}
+
 func (p *Plugin) KillClient() {
 	if p.client != nil {
 		p.client.Kill()
@@ -160,6 +300,15 @@ func (p *Plugin) load(ctx context.Context) {
 	if p.Error != nil {
 		return
 	}
+	if p.manifest != nil {
+		// Prebuilt plugins don't need to be fetched nor built
+		p.installPrebuilt()
+		if p.Error != nil {
+			return
+		}
+		p.start()
+		return
+	}
 	_, err := os.Stat(p.srcPath)
 	if err != nil {
 		// srcPath found, need to fetch the plugin
@@ -174,16 +323,24 @@ func (p *Plugin) load(ctx context.Context) {
 			p.build(ctx)
 		}
 	} else {
-		// Check if binary is already build
-		_, err = os.Stat(p.binaryPath())
-		if err != nil {
-			// binary not found, need to build it
+		// Make sure the plugin sources are the locked ones
+		p.fetchLockedCommit()
+		// Check if binary is already build and matches the lock
+		if !p.binaryMatchesLock(ctx) {
+			// binary not found or outdated, need to build it
 			p.build(ctx)
+			p.resolveBinary(ctx)
 		}
+		p.checkLock()
 	}
 	if p.Error != nil {
 		return
 	}
+	p.start()
+}
+
+// start launches the plugin binary and fills p.Interface.
+func (p *Plugin) start() {
 	// pluginMap is the map of plugins we can dispense.
 	pluginMap := map[string]hplugin.Plugin{
 		p.binaryName: &InterfacePlugin{},
@@ -207,6 +364,11 @@ func (p *Plugin) load(ctx context.Context) {
 	// Connect via RPC
 	rpcClient, err := p.client.Client()
 	if err != nil {
+		if m := incompatibleVersionRe.FindStringSubmatch(err.Error()); m != nil {
+			version, _ := strconv.Atoi(m[1])
+			p.Error = ProtocolVersionError{Version: version}
+			return
+		}
 		p.Error = errors.Wrapf(err, "connecting")
 		return
 	}
@@ -223,7 +385,8 @@ func (p *Plugin) load(ctx context.Context) {
 	p.Interface = raw.(Interface)
 }
 
-// fetch clones the plugin repository at the expected reference.
+// fetch clones the plugin repository at the expected reference, or at the
+// locked commit when the plugin is locked.
 func (p *Plugin) fetch() {
 	if p.isLocal() {
 		return
@@ -233,10 +396,15 @@ func (p *Plugin) fetch() {
 	}
 	defer cliui.New(cliui.StartSpinnerWithText(fmt.Sprintf("Fetching plugin %q...", p.cloneURL))).End()
 
-	var err error
+	locked, isLocked := p.locked()
+
+	var (
+		repo *git.Repository
+		err  error
+	)
 	if p.reference == "" {
 		// No reference provided, just clone
-		_, err = git.PlainClone(p.cloneDir, false, &git.CloneOptions{
+		repo, err = git.PlainClone(p.cloneDir, false, &git.CloneOptions{
 			URL: p.cloneURL,
 		})
 	} else {
@@ -246,12 +414,17 @@ func (p *Plugin) fetch() {
 			plumbing.NewTagReferenceName(p.reference),
 			plumbing.NewBranchReferenceName(p.reference),
 		} {
-			_, err = git.PlainClone(p.cloneDir, false, &git.CloneOptions{
+			opts := &git.CloneOptions{
 				URL:           p.cloneURL,
 				ReferenceName: ref,
 				// Try to limit number of commits but this option doesn't seem to work well
 				Depth: 1,
-			})
+			}
+			if isLocked {
+				// The history is required to find the locked commit
+				opts.Depth = 0
+			}
+			repo, err = git.PlainClone(p.cloneDir, false, opts)
 			if err == nil {
 				break
 			}
@@ -259,6 +432,206 @@ func (p *Plugin) fetch() {
 	}
 	if err != nil {
 		p.Error = errors.Wrapf(err, "cloning %q", p.cloneURL)
+		return
+	}
+	if !isLocked {
+		return
+	}
+	w, err := repo.Worktree()
+	if err == nil {
+		err = w.Checkout(&git.CheckoutOptions{
+			Hash: plumbing.NewHash(locked.Commit),
+		})
+	}
+	if err != nil {
+		p.Error = errors.Wrapf(err, "checking out locked commit %s of %q", locked.Commit, p.cloneURL)
+	}
+}
+
+// fetchLockedCommit fetches the plugin again when the cached sources are not
+// the ones of the locked commit.
+func (p *Plugin) fetchLockedCommit() {
+	if p.Error != nil {
+		return
+	}
+	locked, ok := p.locked()
+	if !ok {
+		return
+	}
+	commit, err := p.headCommit()
+	if err != nil {
+		p.Error = err
+		return
+	}
+	if commit == locked.Commit {
+		return
+	}
+	if p.Error = p.clean(); p.Error != nil {
+		return
+	}
+	p.fetch()
+}
+
+// binaryMatchesLock resolves the version of the plugin binary and returns false
+// when the binary must be built: when it's missing, or when it doesn't match the
+// checksum locked for its platform. The version is read from the binary stamp as
+// long as the binary is unchanged, so the checksum isn't computed on every load
+// and the platform stays the one the binary was built for.
+func (p *Plugin) binaryMatchesLock(ctx context.Context) bool {
+	if p.Error != nil {
+		return false
+	}
+	info, err := os.Stat(p.binaryPath())
+	if err != nil {
+		return false
+	}
+	if p.commit, err = p.headCommit(); err != nil {
+		p.Error = err
+		return false
+	}
+	locked, isLocked := p.locked()
+	if stamp, ok := p.readBinaryStamp(info); ok && stamp.Commit == p.commit {
+		p.platform, p.checksum = stamp.Platform, stamp.Checksum
+		lockedSum, ok := locked.Checksums[p.platform]
+		return !isLocked || !ok || lockedSum == p.checksum
+	}
+	// The binary changed or was built from other sources, a locked plugin
+	// keeps it only when it matches the checksum locked for the platform.
+	p.resolveBinary(ctx)
+	if p.Error != nil {
+		return false
+	}
+	lockedSum, ok := locked.Checksums[p.platform]
+	return !isLocked || (ok && lockedSum == p.checksum)
+}
+
+// resolveBinary resolves the commit, the build platform and the checksum of the
+// plugin binary, and records them in the binary stamp.
+func (p *Plugin) resolveBinary(ctx context.Context) {
+	if p.Error != nil {
+		return
+	}
+	var err error
+	if p.commit, err = p.headCommit(); err != nil {
+		p.Error = err
+		return
+	}
+	if p.platform, err = p.buildPlatform(ctx); err != nil {
+		p.Error = err
+		return
+	}
+	if p.checksum, err = checksum.Binary(p.binaryPath()); err != nil {
+		p.Error = errors.Wrap(err, "computing plugin binary checksum")
+		return
+	}
+	p.Error = p.writeBinaryStamp()
+}
+
+// checkLock verifies the resolved commit and binary checksum of the plugin
+// against the locked ones. The checksum is only verified when one is locked
+// for the build platform.
+func (p *Plugin) checkLock() {
+	if p.Error != nil {
+		return
+	}
+	locked, ok := p.locked()
+	if !ok {
+		return
+	}
+	if p.commit != locked.Commit {
+		p.Error = errors.Errorf("plugin commit %s doesn't match commit %s locked in %s",
+			p.commit, locked.Commit, p.lock.Path())
+		return
+	}
+	if lockedSum, ok := locked.Checksums[p.platform]; ok && p.checksum != lockedSum {
+		p.Error = errors.Errorf(
+			"plugin binary built from commit %s doesn't match the checksum locked in %s for %s",
+			p.commit, p.lock.Path(), p.platform)
+	}
+}
+
+// binaryStamp records the resolved version of a plugin binary with the size
+// and the modification time of the binary, the version is valid as long as
+// the binary is unchanged.
+type binaryStamp struct {
+	Commit   string `yaml:"commit"`
+	Platform string `yaml:"platform"`
+	Checksum string `yaml:"checksum"`
+	Size     int64  `yaml:"size"`
+	ModTime  int64  `yaml:"mod_time"`
+}
+
+func (p *Plugin) binaryStampPath() string {
+	return p.binaryPath() + ".stamp"
+}
+
+// readBinaryStamp returns the binary stamp of the plugin, which is not valid
+// when it doesn't exist or when the binary info is different.
+func (p *Plugin) readBinaryStamp(info fs.FileInfo) (binaryStamp, bool) {
+	var stamp binaryStamp
+	bz, err := os.ReadFile(p.binaryStampPath())
+	if err != nil || yaml.Unmarshal(bz, &stamp) != nil {
+		return stamp, false
+	}
+	return stamp, stamp.Size == info.Size() && stamp.ModTime == info.ModTime().UnixNano()
+}
+
+// writeBinaryStamp records the resolved version of the plugin binary.
+func (p *Plugin) writeBinaryStamp() error {
+	info, err := os.Stat(p.binaryPath())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	bz, err := yaml.Marshal(binaryStamp{
+		Commit:   p.commit,
+		Platform: p.platform,
+		Checksum: p.checksum,
+		Size:     info.Size(),
+		ModTime:  info.ModTime().UnixNano(),
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(p.binaryStampPath(), bz, 0o644))
+}
+
+// buildPlatform returns the platform the plugin binary is built for,
+// formatted as "GOOS/GOARCH/GOVERSION".
+func (p *Plugin) buildPlatform(ctx context.Context) (string, error) {
+	env, err := gocmd.Env(ctx, p.srcPath, "GOOS", "GOARCH", "GOVERSION")
+	if err != nil {
+		return "", errors.Wrap(err, "go env")
+	}
+	return strings.Join(env, "/"), nil
+}
+
+// headCommit returns the hash of the commit checked out in the plugin clone.
+func (p *Plugin) headCommit() (string, error) {
+	repo, err := git.PlainOpen(p.cloneDir)
+	if err != nil {
+		return "", errors.Wrapf(err, "opening plugin repository %q", p.cloneDir)
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return "", errors.Wrapf(err, "reading plugin repository %q", p.cloneDir)
+	}
+	return head.Hash().String(), nil
+}
+
+// locked returns the locked version of the plugin.
+func (p *Plugin) locked() (LockedPlugin, bool) {
+	if p.lock == nil {
+		return LockedPlugin{}, false
+	}
+	return p.lock.Get(p.Path)
+}
+
+// lockedPlugin returns the resolved version of the plugin.
+func (p *Plugin) lockedPlugin() LockedPlugin {
+	return LockedPlugin{
+		Path:      p.Path,
+		Commit:    p.commit,
+		Checksums: map[string]string{p.platform: p.checksum},
 	}
 }
 
@@ -273,7 +646,11 @@ func (p *Plugin) build(ctx context.Context) {
 		p.Error = errors.Wrapf(err, "go mod tidy")
 		return
 	}
-	if err := gocmd.BuildAll(ctx, p.binaryName, p.srcPath, nil); err != nil {
+	// Paths are trimmed and the VCS info is omitted so the binary checksum
+	// doesn't depend on the location nor on the untracked files of the plugin
+	// sources.
+	flags := []string{"-trimpath", "-buildvcs=false"}
+	if err := gocmd.BuildAll(ctx, p.binaryName, p.srcPath, flags); err != nil {
 		p.Error = errors.Wrapf(err, "go build")
 		return
 	}
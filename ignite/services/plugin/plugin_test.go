@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
 )
 
 func TestNewPlugin(t *testing.T) {
@@ -169,6 +171,33 @@ func TestPluginLoad(t *testing.T) {
 		return repoDir, repo
 	}
 
+	// lockedRemotePlugin returns a remote plugin locked to the HEAD commit of its
+	// repository, with a binary checksum locked for platform.
+	lockedRemotePlugin := func(t *testing.T, name, platform, checksum string) Plugin {
+		repoDir, repo := makeGitRepo(t, name)
+		h, err := repo.Head()
+		require.NoError(t, err)
+
+		lock, err := ParseLock(path.Join(mkdirTmp(t, "lock_dir"), LockFileName))
+		require.NoError(t, err)
+		lock.Set(LockedPlugin{
+			Path:      "github.com/ignite/" + name,
+			Commit:    h.Hash().String(),
+			Checksums: map[string]string{platform: checksum},
+		})
+
+		cloneDir := mkdirTmp(t, "clone_dir")
+
+		return Plugin{
+			Plugin:     chainconfig.Plugin{Path: "github.com/ignite/" + name},
+			cloneURL:   repoDir,
+			cloneDir:   cloneDir,
+			srcPath:    path.Join(cloneDir, name),
+			binaryName: name,
+			lock:       lock,
+		}
+	}
+
 	tests := []struct {
 		name          string
 		buildPlugin   func(t *testing.T) Plugin
@@ -296,6 +325,21 @@ func TestPluginLoad(t *testing.T) {
 			},
 			expectedError: `cloning ".*": reference not found`,
 		},
+		{
+			name: "ok: binary checksum locked for another platform",
+			buildPlugin: func(t *testing.T) Plugin {
+				return lockedRemotePlugin(t, "remote-locked-platform", "windows/arm64/go1.0", "invalid")
+			},
+		},
+		{
+			name: "fail: binary checksum doesn't match the lock",
+			buildPlugin: func(t *testing.T) Plugin {
+				env, err := gocmd.Env(context.Background(), "", "GOOS", "GOARCH", "GOVERSION")
+				require.NoError(t, err)
+				return lockedRemotePlugin(t, "remote-locked", strings.Join(env, "/"), "invalid")
+			},
+			expectedError: `plugin binary built from commit \w+ doesn't match the checksum locked in .*plugins.lock for \w+/\w+/go`,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -313,6 +357,116 @@ func TestPluginLoad(t *testing.T) {
 			assert.Equal(t, p.binaryName, p.Interface.Commands()[0].Use)
 		})
 	}
+
+	t.Run("ok: binary version resolved once", func(t *testing.T) {
+		// Arrange
+		require := require.New(t)
+		ctx := context.Background()
+		p := lockedRemotePlugin(t, "remote-stamp", "windows/arm64/go1.0", "invalid")
+		p.load(ctx)
+		require.NoError(p.Error)
+		p.KillClient()
+
+		// Change the recorded version to check it's used as is
+		info, err := os.Stat(p.binaryPath())
+		require.NoError(err)
+		stamp, ok := p.readBinaryStamp(info)
+		require.True(ok)
+		require.Equal(p.platform, stamp.Platform)
+		p.platform, p.checksum = "fake/platform/go0", "fake"
+		require.NoError(p.writeBinaryStamp())
+		reload := func() *Plugin {
+			next := &Plugin{
+				Plugin:     p.Plugin,
+				cloneURL:   p.cloneURL,
+				cloneDir:   p.cloneDir,
+				srcPath:    p.srcPath,
+				binaryName: p.binaryName,
+				lock:       p.lock,
+			}
+			next.load(ctx)
+			require.NoError(next.Error)
+			next.KillClient()
+			return next
+		}
+
+		// Act
+		unchanged := reload()
+		now := time.Now().Add(time.Minute)
+		require.NoError(os.Chtimes(p.binaryPath(), now, now))
+		changed := reload()
+
+		// Assert
+		require.Equal("fake/platform/go0", unchanged.platform)
+		require.Equal("fake", unchanged.checksum)
+		require.Equal(stamp.Platform, changed.platform)
+		require.Equal(stamp.Checksum, changed.checksum)
+	})
+}
+
+func TestPluginFetchLockedCommit(t *testing.T) {
+	// Arrange
+	var (
+		repoDir = t.TempDir()
+		commits []string
+	)
+	repo, err := git.PlainInit(repoDir, false)
+	require.NoError(t, err)
+	w, err := repo.Worktree()
+	require.NoError(t, err)
+	for _, content := range []string{"v1", "v2"} {
+		err := os.WriteFile(path.Join(repoDir, "version"), []byte(content), 0o644)
+		require.NoError(t, err)
+		_, err = w.Add("version")
+		require.NoError(t, err)
+		h, err := w.Commit(content, &git.CommitOptions{
+			Author: &object.Signature{Name: "bob", Email: "bob@example.com", When: time.Now()},
+		})
+		require.NoError(t, err)
+		commits = append(commits, h.String())
+	}
+
+	lock, err := ParseLock(path.Join(t.TempDir(), LockFileName))
+	require.NoError(t, err)
+
+	cloneDir := t.TempDir()
+	p := &Plugin{
+		Plugin:   chainconfig.Plugin{Path: "github.com/ignite/plugin"},
+		cloneURL: repoDir,
+		cloneDir: cloneDir,
+		lock:     lock,
+	}
+	require.NoError(t, os.RemoveAll(cloneDir))
+	p.fetch()
+	require.NoError(t, p.Error)
+	commit, err := p.headCommit()
+	require.NoError(t, err)
+	require.Equal(t, commits[1], commit)
+
+	// Act
+	lock.Set(LockedPlugin{Path: p.Path, Commit: commits[0]})
+	p.fetchLockedCommit()
+
+	// Assert
+	require.NoError(t, p.Error)
+	commit, err = p.headCommit()
+	require.NoError(t, err)
+	require.Equal(t, commits[0], commit)
+	bz, err := os.ReadFile(path.Join(cloneDir, "version"))
+	require.NoError(t, err)
+	require.Equal(t, "v1", string(bz))
+}
+
+func TestProtocolVersionError(t *testing.T) {
+	err := fmt.Errorf("Incompatible API version with plugin. Plugin version: 1, Client versions: [2]")
+
+	m := incompatibleVersionRe.FindStringSubmatch(err.Error())
+
+	require.Equal(t, []string{"Incompatible API version with plugin. Plugin version: 1", "1"}, m)
+	require.EqualError(t, ProtocolVersionError{Version: 1},
+		fmt.Sprintf("plugin protocol version 1 is not compatible with ignite protocol version %d, "+
+			"the plugin must be upgraded to a version that supports this ignite version",
+			handshakeConfig.ProtocolVersion))
 }
 
 func TestPluginClean(t *testing.T) {
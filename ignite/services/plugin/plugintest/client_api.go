@@ -0,0 +1,94 @@
+package plugintest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/services/plugin"
+)
+
+// ErrNoChain is returned by the fake client API when the harness is
+// created without a chain.
+var ErrNoChain = errors.New("ignite is not executed inside a chain directory")
+
+// ChainInfo returns the information of a fake chain named mars, which is
+// the chain given by default to the plugins under test.
+func ChainInfo() plugin.ChainInfo {
+	return plugin.ChainInfo{
+		AppPath:    "/home/alice/mars",
+		ConfigPath: "/home/alice/mars/config.yml",
+		Home:       "/home/alice/.mars",
+		BinaryName: "marsd",
+		ChainID:    "mars",
+		Config:     chainconfig.DefaultConfig(),
+	}
+}
+
+// ClientAPI is a fake ignite client API that records the calls of the
+// plugins under test.
+type ClientAPI struct {
+	chain   *plugin.ChainInfo
+	answers map[string]string
+
+	mu        sync.Mutex
+	events    []events.Event
+	questions []plugin.Question
+}
+
+// GetChainInfo implements plugin.ClientAPI.GetChainInfo.
+func (a *ClientAPI) GetChainInfo() (plugin.ChainInfo, error) {
+	if a.chain == nil {
+		return plugin.ChainInfo{}, ErrNoChain
+	}
+	return *a.chain, nil
+}
+
+// SendEvent implements plugin.ClientAPI.SendEvent.
+func (a *ClientAPI) SendEvent(e events.Event) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.events = append(a.events, e)
+	return nil
+}
+
+// Ask implements plugin.ClientAPI.Ask.
+// The answers are the ones of the WithAnswers option or the default answers
+// of the questions.
+func (a *ClientAPI) Ask(questions ...plugin.Question) (map[string]string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.questions = append(a.questions, questions...)
+	answers := make(map[string]string, len(questions))
+	for _, q := range questions {
+		answer, ok := a.answers[q.Name]
+		if !ok {
+			answer = q.DefaultAnswer
+		}
+		if q.Required && answer == "" {
+			return nil, fmt.Errorf("missing answer for required question %q", q.Name)
+		}
+		answers[q.Name] = answer
+	}
+	return answers, nil
+}
+
+// Events returns the events sent by the plugin.
+func (a *ClientAPI) Events() []events.Event {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return append([]events.Event(nil), a.events...)
+}
+
+// Questions returns the questions asked by the plugin.
+func (a *ClientAPI) Questions() []plugin.Question {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return append([]plugin.Question(nil), a.questions...)
+}
@@ -0,0 +1,234 @@
+// Package plugintest provides a harness to test ignite plugins without
+// ignite.
+//
+// The harness connects to a plugin the same way ignite does, through the
+// go-plugin RPC protocol, either in-process with New or by starting the
+// plugin binary with Start. The plugin methods are called with a fake ignite
+// client API and a fake chain, and the plugin output is captured.
+package plugintest
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	hplugin "github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/services/plugin"
+)
+
+// Option configures the harness.
+type Option func(*Harness)
+
+// WithChain sets the chain given to the plugin, which defaults to ChainInfo.
+func WithChain(chain plugin.ChainInfo) Option {
+	return func(h *Harness) {
+		h.api.chain = &chain
+	}
+}
+
+// WithoutChain simulates ignite executed outside of a chain directory.
+func WithoutChain() Option {
+	return func(h *Harness) {
+		h.api.chain = nil
+	}
+}
+
+// WithAnswers sets the answers of the questions asked by the plugin,
+// indexed by question name.
+func WithAnswers(answers map[string]string) Option {
+	return func(h *Harness) {
+		h.api.answers = answers
+	}
+}
+
+// Harness calls the methods of a plugin the way ignite does.
+type Harness struct {
+	plugin    plugin.Interface
+	api       *ClientAPI
+	output    syncBuffer
+	inProcess bool
+}
+
+// New connects in-process to a plugin implementation.
+// The output written by the plugin to the standard output and error is
+// captured during the calls of the harness methods, so plugins under test
+// must not be used concurrently.
+func New(t *testing.T, impl plugin.Interface, options ...Option) *Harness {
+	t.Helper()
+
+	h := newHarness(options)
+	h.inProcess = true
+
+	client, _ := hplugin.TestPluginRPCConn(t, map[string]hplugin.Plugin{
+		"plugin": &plugin.InterfacePlugin{Impl: impl},
+	}, nil)
+	t.Cleanup(func() { client.Close() })
+
+	raw, err := client.Dispense("plugin")
+	require.NoError(t, err)
+	h.plugin = raw.(plugin.Interface)
+	return h
+}
+
+// Start starts a plugin binary and connects to it through the go-plugin
+// handshake, as ignite does. The plugin must be served with the name of
+// the binary.
+// The output of the plugin process is forwarded asynchronously, so it may
+// not be complete right after a call.
+func Start(t *testing.T, binaryPath string, options ...Option) *Harness {
+	t.Helper()
+
+	h := newHarness(options)
+	name := filepath.Base(binaryPath)
+	client := hplugin.NewClient(&hplugin.ClientConfig{
+		HandshakeConfig: plugin.HandshakeConfig(),
+		Plugins: map[string]hplugin.Plugin{
+			name: &plugin.InterfacePlugin{},
+		},
+		Cmd:        exec.Command(binaryPath),
+		SyncStdout: &h.output,
+		SyncStderr: &h.output,
+	})
+	t.Cleanup(client.Kill)
+
+	rpcClient, err := client.Client()
+	require.NoError(t, err)
+
+	raw, err := rpcClient.Dispense(name)
+	require.NoError(t, err)
+	h.plugin = raw.(plugin.Interface)
+	return h
+}
+
+func newHarness(options []Option) *Harness {
+	chain := ChainInfo()
+	h := &Harness{
+		api: &ClientAPI{chain: &chain},
+	}
+	for _, apply := range options {
+		apply(h)
+	}
+	return h
+}
+
+// API returns the fake client API given to the plugin.
+func (h *Harness) API() *ClientAPI {
+	return h.api
+}
+
+// Output returns the output written by the plugin so far.
+func (h *Harness) Output() string {
+	return h.output.String()
+}
+
+// Commands calls the Commands method of the plugin.
+func (h *Harness) Commands() (commands []plugin.Command) {
+	h.capture(func() error {
+		commands = h.plugin.Commands()
+		return nil
+	})
+	return commands
+}
+
+// Hooks calls the Hooks method of the plugin.
+func (h *Harness) Hooks() (hooks []plugin.Hook) {
+	h.capture(func() error {
+		hooks = h.plugin.Hooks()
+		return nil
+	})
+	return hooks
+}
+
+// Execute executes a plugin command.
+func (h *Harness) Execute(cmd plugin.Command, args ...string) error {
+	return h.capture(func() error {
+		return h.plugin.Execute(cmd, args, h.api)
+	})
+}
+
+// ExecuteHookPre executes a pre hook of the plugin.
+// The hook is given the harness chain when it doesn't define one.
+func (h *Harness) ExecuteHookPre(hook plugin.ExecutedHook) (result plugin.HookResult, err error) {
+	err = h.capture(func() (err error) {
+		result, err = h.plugin.ExecuteHookPre(h.withChain(hook), h.api)
+		return err
+	})
+	return result, err
+}
+
+// ExecuteHookPost executes a post hook of the plugin.
+// The hook is given the harness chain when it doesn't define one.
+func (h *Harness) ExecuteHookPost(hook plugin.ExecutedHook) error {
+	return h.capture(func() error {
+		return h.plugin.ExecuteHookPost(h.withChain(hook), h.api)
+	})
+}
+
+// ExecuteHookCleanUp executes a clean up hook of the plugin.
+// The hook is given the harness chain when it doesn't define one.
+func (h *Harness) ExecuteHookCleanUp(hook plugin.ExecutedHook) error {
+	return h.capture(func() error {
+		return h.plugin.ExecuteHookCleanUp(h.withChain(hook), h.api)
+	})
+}
+
+func (h *Harness) withChain(hook plugin.ExecutedHook) plugin.ExecutedHook {
+	if hook.Chain == nil && h.api.chain != nil {
+		chain := *h.api.chain
+		hook.Chain = &chain
+	}
+	return hook
+}
+
+// capture calls a plugin method and captures the output of in-process
+// plugins. The output of plugin processes is captured by go-plugin.
+func (h *Harness) capture(call func() error) error {
+	if !h.inProcess {
+		return call()
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	stdout, stderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = w, w
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		io.Copy(&h.output, r)
+	}()
+
+	err = call()
+
+	os.Stdout, os.Stderr = stdout, stderr
+	w.Close()
+	<-done
+	r.Close()
+	return err
+}
+
+// syncBuffer is a buffer that can be written concurrently.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
@@ -0,0 +1,133 @@
+package plugintest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/events"
+	"github.com/ignite/cli/ignite/pkg/gocmd"
+	"github.com/ignite/cli/ignite/services/plugin"
+	"github.com/ignite/cli/ignite/services/plugin/plugintest"
+)
+
+// testPlugin is a plugin that uses the client API and writes output.
+type testPlugin struct{}
+
+func (testPlugin) Commands() []plugin.Command {
+	return []plugin.Command{{Use: "hello"}}
+}
+
+func (testPlugin) Hooks() []plugin.Hook {
+	return []plugin.Hook{{Name: "check", PlaceHookOn: "chain serve"}}
+}
+
+func (testPlugin) Execute(cmd plugin.Command, args []string, api plugin.ClientAPI) error {
+	chain, err := api.GetChainInfo()
+	if err != nil {
+		return err
+	}
+	answers, err := api.Ask(plugin.Question{Name: "name", Text: "Name?", Required: true})
+	if err != nil {
+		return err
+	}
+	if err := api.SendEvent(events.New("done")); err != nil {
+		return err
+	}
+	fmt.Printf("hello %s from %s\n", answers["name"], chain.ChainID)
+	fmt.Fprintln(os.Stderr, "warning")
+	return nil
+}
+
+func (testPlugin) ExecuteHookPre(hook plugin.ExecutedHook, _ plugin.ClientAPI) (plugin.HookResult, error) {
+	if hook.Chain == nil {
+		return plugin.HookResult{Decision: plugin.HookAbort, Reason: "not a chain"}, nil
+	}
+	return plugin.HookResult{Args: []string{hook.Chain.ChainID}}, nil
+}
+
+func (testPlugin) ExecuteHookPost(hook plugin.ExecutedHook, _ plugin.ClientAPI) error {
+	if hook.ExecuteError != "" {
+		return errors.New(hook.ExecuteError)
+	}
+	return nil
+}
+
+func (testPlugin) ExecuteHookCleanUp(plugin.ExecutedHook, plugin.ClientAPI) error {
+	fmt.Println("cleaned up")
+	return nil
+}
+
+func TestHarness(t *testing.T) {
+	// Arrange
+	h := plugintest.New(t, testPlugin{}, plugintest.WithAnswers(map[string]string{"name": "alice"}))
+
+	// Act
+	err := h.Execute(plugin.Command{Use: "hello"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []plugin.Command{{Use: "hello"}}, h.Commands())
+	require.Equal(t, "hello alice from mars\nwarning\n", h.Output())
+	require.Equal(t, []events.Event{events.New("done")}, h.API().Events())
+	require.Equal(t, []plugin.Question{{Name: "name", Text: "Name?", Required: true}}, h.API().Questions())
+}
+
+func TestHarnessWithoutChain(t *testing.T) {
+	// Arrange
+	h := plugintest.New(t, testPlugin{}, plugintest.WithoutChain())
+
+	// Act
+	err := h.Execute(plugin.Command{Use: "hello"})
+	result, hookErr := h.ExecuteHookPre(plugin.ExecutedHook{Hook: h.Hooks()[0]})
+
+	// Assert
+	require.EqualError(t, err, plugintest.ErrNoChain.Error())
+	require.NoError(t, hookErr)
+	require.Equal(t, plugin.HookResult{Decision: plugin.HookAbort, Reason: "not a chain"}, result)
+}
+
+func TestHarnessHooks(t *testing.T) {
+	// Arrange
+	h := plugintest.New(t, testPlugin{})
+	hook := plugin.ExecutedHook{Hook: h.Hooks()[0], CommandPath: "ignite chain serve"}
+
+	// Act
+	result, err := h.ExecuteHookPre(hook)
+	require.NoError(t, err)
+	hook.ExecuteError = "oops"
+	postErr := h.ExecuteHookPost(hook)
+	cleanUpErr := h.ExecuteHookCleanUp(hook)
+
+	// Assert
+	require.Equal(t, plugin.HookResult{Args: []string{"mars"}}, result)
+	require.EqualError(t, postErr, "oops")
+	require.NoError(t, cleanUpErr)
+	require.Equal(t, "cleaned up\n", h.Output())
+}
+
+func TestHarnessStart(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	err := gocmd.BuildPath(context.Background(), dir, "echo", "testdata/echo", nil)
+	require.NoError(t, err)
+	binaryPath := filepath.Join(dir, "echo")
+
+	h := plugintest.Start(t, binaryPath)
+
+	// Act
+	err = h.Execute(plugin.Command{Use: "echo"}, "foo", "bar")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []plugin.Command{{Use: "echo"}}, h.Commands())
+	require.Eventually(t, func() bool {
+		return h.Output() == "mars: foo bar\n"
+	}, 5*time.Second, 10*time.Millisecond)
+}
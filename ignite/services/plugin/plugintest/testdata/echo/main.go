@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	hplugin "github.com/hashicorp/go-plugin"
+
+	"github.com/ignite/cli/ignite/services/plugin"
+)
+
+// echo is a plugin that prints the arguments of the executed command.
+type echo struct{}
+
+func (echo) Commands() []plugin.Command {
+	return []plugin.Command{{Use: "echo"}}
+}
+
+func (echo) Hooks() []plugin.Hook { return nil }
+
+func (echo) Execute(cmd plugin.Command, args []string, api plugin.ClientAPI) error {
+	chain, err := api.GetChainInfo()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s: %s\n", chain.ChainID, strings.Join(args, " "))
+	return nil
+}
+
+func (echo) ExecuteHookPre(plugin.ExecutedHook, plugin.ClientAPI) (plugin.HookResult, error) {
+	return plugin.HookResult{}, nil
+}
+
+func (echo) ExecuteHookPost(plugin.ExecutedHook, plugin.ClientAPI) error { return nil }
+
+func (echo) ExecuteHookCleanUp(plugin.ExecutedHook, plugin.ClientAPI) error { return nil }
+
+func main() {
+	hplugin.Serve(&hplugin.ServeConfig{
+		HandshakeConfig: plugin.HandshakeConfig(),
+		Plugins: map[string]hplugin.Plugin{
+			"echo": &plugin.InterfacePlugin{Impl: echo{}},
+		},
+	})
+}
@@ -0,0 +1,151 @@
+package plugin
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/ignite/cli/ignite/pkg/checksum"
+	"github.com/ignite/cli/ignite/pkg/tarball"
+)
+
+// ManifestFileName is the name of the manifest file of prebuilt plugins.
+// Prebuilt plugins are either directories or tarballs that contain the
+// manifest file and the plugin binary.
+const ManifestFileName = "manifest.yml"
+
+// archivesDir is the directory of the plugins cache where prebuilt plugin
+// archives are unpacked.
+const archivesDir = "archives"
+
+// Manifest describes a prebuilt plugin.
+type Manifest struct {
+	// Name is the plugin name, which is also the name of the plugin binary.
+	Name string `yaml:"name"`
+	// Version is the plugin version.
+	Version string `yaml:"version"`
+	// ProtocolVersion is the protocol version the plugin binary is built with.
+	ProtocolVersion uint `yaml:"protocol_version"`
+	// Checksum is the SHA256 checksum of the plugin binary.
+	Checksum string `yaml:"checksum"`
+}
+
+// ParseManifest reads a prebuilt plugin manifest.
+func ParseManifest(r io.Reader) (Manifest, error) {
+	var m Manifest
+	if err := yaml.NewDecoder(r).Decode(&m); err != nil {
+		return Manifest{}, errors.Wrap(err, "parsing plugin manifest")
+	}
+	return m, m.Validate()
+}
+
+// Validate checks that the manifest fields are valid.
+func (m Manifest) Validate() error {
+	switch {
+	case m.Name == "":
+		return errors.New(`missing plugin manifest property "name"`)
+	case m.Name != path.Base(m.Name) || m.Name == "." || m.Name == "..":
+		return errors.Errorf("invalid plugin manifest name %q", m.Name)
+	case m.Checksum == "":
+		return errors.New(`missing plugin manifest property "checksum"`)
+	}
+	return nil
+}
+
+// isArchive returns true when the path is a plugin tarball.
+func isArchive(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+// readDirManifest reads the manifest of a prebuilt plugin directory.
+// It returns false when the directory doesn't contain a manifest.
+func readDirManifest(dir string) (Manifest, bool, error) {
+	f, err := os.Open(path.Join(dir, ManifestFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Manifest{}, false, nil
+		}
+		return Manifest{}, false, errors.WithStack(err)
+	}
+	defer f.Close()
+
+	m, err := ParseManifest(f)
+	return m, true, err
+}
+
+// readArchiveManifest reads the manifest of a prebuilt plugin tarball.
+func readArchiveManifest(archivePath string) (Manifest, error) {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return Manifest{}, errors.WithStack(err)
+	}
+	defer f.Close()
+
+	var buf bytes.Buffer
+	if _, err := tarball.ExtractFile(f, &buf, ManifestFileName); err != nil {
+		return Manifest{}, errors.Wrapf(err, "reading %s", ManifestFileName)
+	}
+	return ParseManifest(&buf)
+}
+
+// installPrebuilt unpacks the binary of a prebuilt plugin archive when it's
+// not unpacked yet, and verifies the binary against the plugin manifest.
+func (p *Plugin) installPrebuilt() {
+	if p.Error != nil {
+		return
+	}
+	if p.manifest.ProtocolVersion != handshakeConfig.ProtocolVersion {
+		p.Error = ProtocolVersionError{Version: int(p.manifest.ProtocolVersion)}
+		return
+	}
+	if p.archivePath != "" && !p.binaryMatchesManifest() {
+		if err := p.unpackArchive(); err != nil {
+			p.Error = errors.Wrapf(err, "unpacking plugin archive %q", p.archivePath)
+			return
+		}
+	}
+	sum, err := checksum.Binary(p.binaryPath())
+	if err != nil {
+		p.Error = errors.Wrapf(err, "computing prebuilt plugin binary %q checksum", p.binaryPath())
+		return
+	}
+	if sum != p.manifest.Checksum {
+		p.Error = errors.Errorf("prebuilt plugin binary checksum %s doesn't match manifest checksum %s",
+			sum, p.manifest.Checksum)
+	}
+}
+
+// binaryMatchesManifest returns true when the plugin binary exists and its
+// checksum is the one of the manifest.
+func (p *Plugin) binaryMatchesManifest() bool {
+	sum, err := checksum.Binary(p.binaryPath())
+	return err == nil && sum == p.manifest.Checksum
+}
+
+// unpackArchive extracts the plugin binary from the plugin archive.
+func (p *Plugin) unpackArchive() error {
+	if err := os.MkdirAll(p.srcPath, 0o755); err != nil {
+		return errors.WithStack(err)
+	}
+	f, err := os.Open(p.archivePath)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer f.Close()
+
+	out, err := os.OpenFile(p.binaryPath(), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer out.Close()
+
+	if _, err := tarball.ExtractFile(f, out, p.manifest.Name); err != nil {
+		return errors.Wrapf(err, "extracting binary %q", p.manifest.Name)
+	}
+	return errors.WithStack(out.Close())
+}
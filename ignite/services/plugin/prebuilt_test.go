@@ -0,0 +1,200 @@
+package plugin
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/chainconfig"
+)
+
+const prebuiltBinary = "#!/bin/sh\necho prebuilt\n"
+
+var prebuiltChecksum = fmt.Sprintf("%x", sha256.Sum256([]byte(prebuiltBinary)))
+
+func TestParseManifest(t *testing.T) {
+	tests := []struct {
+		name             string
+		content          string
+		expectedManifest Manifest
+		expectedError    string
+	}{
+		{
+			name: "ok",
+			content: `name: foo
+version: v1.0.0
+protocol_version: 3
+checksum: abc
+`,
+			expectedManifest: Manifest{
+				Name:            "foo",
+				Version:         "v1.0.0",
+				ProtocolVersion: 3,
+				Checksum:        "abc",
+			},
+		},
+		{
+			name:          "fail: missing name",
+			content:       "checksum: abc\n",
+			expectedError: `missing plugin manifest property "name"`,
+		},
+		{
+			name:          "fail: name is a path",
+			content:       "name: ../foo\nchecksum: abc\n",
+			expectedError: `invalid plugin manifest name "../foo"`,
+		},
+		{
+			name:          "fail: missing checksum",
+			content:       "name: foo\n",
+			expectedError: `missing plugin manifest property "checksum"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseManifest(strings.NewReader(tt.content))
+
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedManifest, m)
+		})
+	}
+}
+
+func TestPrebuiltPlugin(t *testing.T) {
+	manifest := func(protocolVersion uint, checksum string) string {
+		return fmt.Sprintf("name: foo\nversion: v1\nprotocol_version: %d\nchecksum: %s\n", protocolVersion, checksum)
+	}
+	validManifest := manifest(handshakeConfig.ProtocolVersion, prebuiltChecksum)
+
+	tests := []struct {
+		name          string
+		pluginPath    func(t *testing.T) string
+		expectedError string
+	}{
+		{
+			name: "ok: from directory",
+			pluginPath: func(t *testing.T) string {
+				return writePrebuiltDir(t, map[string]string{
+					ManifestFileName: validManifest,
+					"foo":            prebuiltBinary,
+				})
+			},
+		},
+		{
+			name: "ok: from archive",
+			pluginPath: func(t *testing.T) string {
+				return writePrebuiltArchive(t, map[string]string{
+					ManifestFileName: validManifest,
+					"bin/foo":        prebuiltBinary,
+				})
+			},
+		},
+		{
+			name: "fail: checksum mismatch",
+			pluginPath: func(t *testing.T) string {
+				return writePrebuiltArchive(t, map[string]string{
+					ManifestFileName: manifest(handshakeConfig.ProtocolVersion, "invalid"),
+					"foo":            prebuiltBinary,
+				})
+			},
+			expectedError: fmt.Sprintf("prebuilt plugin binary checksum %s doesn't match manifest checksum invalid", prebuiltChecksum),
+		},
+		{
+			name: "fail: incompatible protocol version",
+			pluginPath: func(t *testing.T) string {
+				return writePrebuiltDir(t, map[string]string{
+					ManifestFileName: manifest(1, prebuiltChecksum),
+					"foo":            prebuiltBinary,
+				})
+			},
+			expectedError: ProtocolVersionError{Version: 1}.Error(),
+		},
+		{
+			name: "fail: archive without manifest",
+			pluginPath: func(t *testing.T) string {
+				return writePrebuiltArchive(t, map[string]string{
+					"foo": prebuiltBinary,
+				})
+			},
+			expectedError: `local plugin archive ".*\.tar\.gz": reading manifest.yml: file not found in the gzip`,
+		},
+		{
+			name: "fail: archive without binary",
+			pluginPath: func(t *testing.T) string {
+				return writePrebuiltArchive(t, map[string]string{
+					ManifestFileName: validManifest,
+				})
+			},
+			expectedError: `unpacking plugin archive ".*": extracting binary "foo": file not found in the gzip`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pluginsDir := t.TempDir()
+			p := newPlugin(pluginsDir, chainconfig.Plugin{Path: tt.pluginPath(t)})
+
+			p.installPrebuilt()
+
+			if tt.expectedError != "" {
+				require.Error(t, p.Error)
+				require.Regexp(t, tt.expectedError, p.Error.Error())
+				return
+			}
+			require.NoError(t, p.Error)
+			require.NotNil(t, p.manifest)
+			require.Equal(t, "foo", p.binaryName)
+			require.True(t, p.isLocal())
+			bz, err := os.ReadFile(p.binaryPath())
+			require.NoError(t, err)
+			require.Equal(t, prebuiltBinary, string(bz))
+			if p.archivePath != "" {
+				require.Equal(t, path.Join(pluginsDir, archivesDir, "foo@v1"), p.srcPath)
+			}
+		})
+	}
+}
+
+func writePrebuiltDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		err := os.WriteFile(path.Join(dir, name), []byte(content), 0o755)
+		require.NoError(t, err)
+	}
+	return dir
+}
+
+func writePrebuiltArchive(t *testing.T, files map[string]string) string {
+	t.Helper()
+	archivePath := path.Join(t.TempDir(), "plugin.tar.gz")
+	f, err := os.Create(archivePath)
+	require.NoError(t, err)
+	defer f.Close()
+
+	gw := gzip.NewWriter(f)
+	tw := tar.NewWriter(gw)
+	for name, content := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o755,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})
+		require.NoError(t, err)
+		_, err = tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return archivePath
+}
@@ -6,15 +6,27 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"runtime"
 
 	"github.com/gobuffalo/genny"
 	"github.com/gobuffalo/plush/v4"
 	"github.com/pkg/errors"
+	"golang.org/x/mod/semver"
 
 	"github.com/ignite/cli/ignite/pkg/cmdrunner/exec"
 	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
 	"github.com/ignite/cli/ignite/pkg/gocmd"
+	"github.com/ignite/cli/ignite/pkg/gomodule"
 	"github.com/ignite/cli/ignite/pkg/xgenny"
+	"github.com/ignite/cli/ignite/version"
+)
+
+const (
+	// igniteCLIModule is the module path of Ignite CLI, required by the scaffolded plugins.
+	igniteCLIModule = "github.com/ignite/cli"
+
+	// igniteCLILocalVersion is the version required when Ignite CLI is replaced by local sources.
+	igniteCLILocalVersion = "v0.0.0-00010101000000-000000000000"
 )
 
 //go:embed template/*
@@ -36,12 +48,22 @@ func Scaffold(dir, moduleName string) (string, error) {
 		// finalDir already exists, don't overwrite stuff
 		return "", errors.Errorf("dir %q already exists, abort scaffolding", finalDir)
 	}
+	// The plugin must use the same plugin API as ignite, so it requires the
+	// sources ignite was built from when they're available, or its release.
+	igniteCLIPath, igniteCLIVersion := localIgniteCLIPath(), version.Version
+	if igniteCLIPath != "" {
+		igniteCLIVersion = igniteCLILocalVersion
+	} else if !semver.IsValid(igniteCLIVersion) {
+		return "", errors.Errorf("ignite version %q isn't a release, plugins can't require it", igniteCLIVersion)
+	}
 	if err := g.Box(template); err != nil {
 		return "", errors.WithStack(err)
 	}
 	ctx := plush.NewContext()
 	ctx.Set("ModuleName", moduleName)
 	ctx.Set("Name", name)
+	ctx.Set("IgniteCLIPath", igniteCLIPath)
+	ctx.Set("IgniteCLIVersion", igniteCLIVersion)
 	g.Transformer(xgenny.Transformer(ctx))
 	r := genny.WetRunner(ctx)
 	err := r.With(g)
@@ -60,3 +82,19 @@ func Scaffold(dir, moduleName string) (string, error) {
 	}
 	return finalDir, nil
 }
+
+// localIgniteCLIPath returns the root directory of the Ignite CLI sources the
+// binary was built from, or an empty string when they are not on the disk.
+func localIgniteCLIPath() string {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return ""
+	}
+	// file is ignite/services/plugin/scaffold.go in the sources
+	root := filepath.Join(filepath.Dir(file), "..", "..", "..")
+	gomod, err := gomodule.ParseAt(root)
+	if err != nil || gomod.Module == nil || gomod.Module.Mod.Path != igniteCLIModule {
+		return ""
+	}
+	return root
+}
@@ -1,9 +1,12 @@
 package plugin
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/gomodule"
 )
 
 func TestScaffold(t *testing.T) {
@@ -13,4 +16,17 @@ func TestScaffold(t *testing.T) {
 
 	require.NoError(t, err)
 	require.DirExists(t, path)
+
+	// The plugin must use the ignite sources of the test
+	gomod, err := gomodule.ParseAt(path)
+	require.NoError(t, err)
+	root, err := filepath.Abs(filepath.Join("..", "..", ".."))
+	require.NoError(t, err)
+	var replaced string
+	for _, r := range gomod.Replace {
+		if r.Old.Path == igniteCLIModule {
+			replaced = r.New.Path
+		}
+	}
+	require.Equal(t, root, replaced)
 }
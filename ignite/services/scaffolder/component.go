@@ -13,6 +13,7 @@ import (
 
 	"github.com/ignite/cli/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/ignite/templates/field"
 	"github.com/ignite/cli/ignite/templates/field/datatype"
 )
 
@@ -182,6 +183,53 @@ func checkComponentCreated(appPath, moduleName string, compName multiformatname.
 	return err
 }
 
+// checkEnumsCreated checks the proto enums of the fields are not already
+// defined in the module, by another component or by the fields themselves.
+func checkEnumsCreated(appPath, moduleName string, fields ...field.Fields) error {
+	enums := make(map[string]struct{})
+	for _, f := range fields {
+		for _, name := range f.EnumNames() {
+			if _, ok := enums[name]; ok {
+				return fmt.Errorf("the enum %s is defined twice", name)
+			}
+			enums[name] = struct{}{}
+		}
+	}
+	if len(enums) == 0 {
+		return nil
+	}
+
+	absPath, err := filepath.Abs(filepath.Join(appPath, "x", moduleName, "types"))
+	if err != nil {
+		return err
+	}
+	fileSet := token.NewFileSet()
+	all, err := parser.ParseDir(fileSet, absPath, func(os.FileInfo) bool { return true }, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range all {
+		for _, f := range pkg.Files {
+			ast.Inspect(f, func(x ast.Node) bool {
+				typeSpec, ok := x.(*ast.TypeSpec)
+				if !ok {
+					return err == nil
+				}
+				if _, ok := enums[typeSpec.Name.Name]; ok {
+					err = fmt.Errorf("the enum %s can't be created, type %s already exists in the module %s",
+						typeSpec.Name.Name, typeSpec.Name.Name, moduleName)
+				}
+				return false
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // checkForbiddenOracleFieldName returns true if the name is forbidden as an oracle field name
 func checkForbiddenOracleFieldName(name string) error {
 	mfName, err := multiformatname.NewName(name, multiformatname.NoNumber)
@@ -216,7 +264,7 @@ func checkCustomTypes(ctx context.Context, path, appName, module string, fields
 			continue
 		}
 		fieldType := datatype.Name(fieldSplit[1])
-		if _, ok := datatype.SupportedTypes[fieldType]; !ok {
+		if _, ok := datatype.SupportedTypes[fieldType]; !ok && !datatype.IsEnum(fieldType) {
 			customFields = append(customFields, string(fieldType))
 		}
 	}
@@ -231,7 +279,31 @@ func containCustomTypes(fields []string) bool {
 			continue
 		}
 		fieldType := datatype.Name(fieldSplit[1])
-		if _, ok := datatype.SupportedTypes[fieldType]; !ok {
+		if _, ok := datatype.SupportedTypes[fieldType]; !ok && !datatype.IsEnum(fieldType) {
+			return true
+		}
+	}
+	return false
+}
+
+// containConstraints returns true if at least one of the fields has constraints
+func containConstraints(fields field.Fields) bool {
+	for _, f := range fields {
+		if f.HasConstraints() {
+			return true
+		}
+	}
+	return false
+}
+
+// containEnumTypes returns true if the list of fields contains at least one enum type
+func containEnumTypes(fields []string) bool {
+	for _, name := range fields {
+		fieldSplit := strings.Split(name, datatype.Separator)
+		if len(fieldSplit) <= 1 {
+			continue
+		}
+		if datatype.IsEnum(datatype.Name(fieldSplit[1])) {
 			return true
 		}
 	}
@@ -0,0 +1,75 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/templates/field"
+)
+
+func TestCheckEnumsCreated(t *testing.T) {
+	// Arrange
+	appPath := t.TempDir()
+	typesPath := filepath.Join(appPath, "x", "blog", "types")
+	require.NoError(t, os.MkdirAll(typesPath, 0o755))
+	err := os.WriteFile(filepath.Join(typesPath, "post.pb.go"), []byte(`package types
+
+type PostStatus int32
+
+type Post struct {
+	Status PostStatus
+}
+`), 0o644)
+	require.NoError(t, err)
+
+	parse := func(prefix string, fields ...string) field.Fields {
+		parsed, err := field.ParseFields(fields, checkGoReservedWord)
+		require.NoError(t, err)
+		parsed.SetEnumPrefix(prefix)
+		return parsed
+	}
+
+	tests := []struct {
+		name   string
+		fields []field.Fields
+		err    string
+	}{
+		{
+			name:   "no enums",
+			fields: []field.Fields{parse("Post", "title", "status")},
+		},
+		{
+			name:   "new enum",
+			fields: []field.Fields{parse("Comment", "status:enum=open|closed")},
+		},
+		{
+			name:   "enum of another type",
+			fields: []field.Fields{parse("Post", "status:enum=open|closed")},
+			err:    "the enum PostStatus can't be created, type PostStatus already exists in the module blog",
+		},
+		{
+			name: "enum defined twice",
+			fields: []field.Fields{
+				parse("Vote", "responseStatus:enum=a|b"),
+				parse("VoteResponse", "status:enum=c|d"),
+			},
+			err: "the enum VoteResponseStatus is defined twice",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := checkEnumsCreated(appPath, "blog", tt.fields...)
+
+			// Assert
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package scaffolder
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+// Kinds of scaffolded components recorded in the journal.
+const (
+	ComponentType    = "type"
+	ComponentMessage = "message"
+	ComponentQuery   = "query"
+	ComponentModule  = "module"
+	ComponentPacket  = "packet"
+)
+
+// journalPath is the path of the scaffold journal, relative to the app path.
+var journalPath = filepath.Join(".ignite", "journal.json")
+
+// journal keeps track of the source modifications of each scaffolded
+// component so they can be reverted later.
+type journal struct {
+	Entries []journalEntry `json:"entries"`
+}
+
+// journalEntry describes the source modification of a scaffolded component.
+// File paths are relative to the app path.
+type journalEntry struct {
+	Kind     string              `json:"kind"`
+	Module   string              `json:"module"`
+	Name     string              `json:"name"`
+	Created  []string            `json:"created,omitempty"`
+	Modified []string            `json:"modified,omitempty"`
+	Inserted map[string][]string `json:"inserted,omitempty"`
+}
+
+// uses checks if the entry has created or modified the file.
+func (e journalEntry) uses(file string) bool {
+	for _, f := range append(e.Created, e.Modified...) {
+		if f == file {
+			return true
+		}
+	}
+	return false
+}
+
+// inserted returns the snippet inserted in the file by an entry that matches the
+// snippet, regardless of the whitespaces. Code found in the files before scaffolding
+// is not matched, it must never be removed.
+func (j journal) inserted(file, snippet string) (string, bool) {
+	for _, e := range j.Entries {
+		for _, inserted := range e.Inserted[file] {
+			if placeholder.NormalizeSpaces(inserted) == placeholder.NormalizeSpaces(snippet) {
+				return inserted, true
+			}
+		}
+	}
+	return "", false
+}
+
+// loadJournal reads the scaffold journal of an app, the journal is empty
+// if the app doesn't have one yet.
+func loadJournal(appPath string) (j journal, err error) {
+	data, err := os.ReadFile(filepath.Join(appPath, journalPath))
+	if errors.Is(err, os.ErrNotExist) {
+		return j, nil
+	}
+	if err != nil {
+		return j, err
+	}
+	return j, json.Unmarshal(data, &j)
+}
+
+// save writes the scaffold journal of an app.
+func (j journal) save(appPath string) error {
+	path := filepath.Join(appPath, journalPath)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(j, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// record adds the source modification of a scaffolded component to the journal of the app.
+// Nothing is recorded in dry run mode.
+func (s Scaffolder) record(kind, moduleName, name string, sm xgenny.SourceModification) error {
+	if s.dryRun != nil {
+		return nil
+	}
+
+	j, err := loadJournal(s.path)
+	if err != nil {
+		return err
+	}
+
+	entry := journalEntry{
+		Kind:     kind,
+		Module:   moduleName,
+		Name:     name,
+		Inserted: make(map[string][]string),
+	}
+	rel := func(files []string) ([]string, error) {
+		relFiles := make([]string, 0, len(files))
+		for _, file := range files {
+			relFile, err := filepath.Rel(s.path, file)
+			if err != nil {
+				return nil, err
+			}
+			relFiles = append(relFiles, relFile)
+		}
+		sort.Strings(relFiles)
+		return relFiles, nil
+	}
+	if entry.Created, err = rel(sm.CreatedFiles()); err != nil {
+		return err
+	}
+	if entry.Modified, err = rel(sm.ModifiedFiles()); err != nil {
+		return err
+	}
+	for _, file := range sm.ModifiedFiles() {
+		if snippets := sm.Insertions(file); len(snippets) > 0 {
+			relFile, err := filepath.Rel(s.path, file)
+			if err != nil {
+				return err
+			}
+			entry.Inserted[relFile] = snippets
+		}
+	}
+	// The snippets reused from the previous components are recorded as inserted by
+	// the component too, so they are kept as long as a component relies on them.
+	for _, file := range sm.ModifiedFiles() {
+		relFile, err := filepath.Rel(s.path, file)
+		if err != nil {
+			return err
+		}
+		for _, snippet := range sm.Reused(file) {
+			if inserted, ok := j.inserted(relFile, snippet); ok {
+				entry.Inserted[relFile] = append(entry.Inserted[relFile], inserted)
+			}
+		}
+	}
+
+	j.Entries = append(j.Entries, entry)
+	return j.save(s.path)
+}
@@ -0,0 +1,45 @@
+package scaffolder
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+func TestRecordReusedSnippets(t *testing.T) {
+	// Arrange
+	var (
+		appPath   = t.TempDir()
+		s         = Scaffolder{path: appPath}
+		codecFile = filepath.Join(appPath, "x/blog/types/codec.go")
+		sdkImport = "\tsdk \"github.com/cosmos/cosmos-sdk/types\"\n"
+	)
+	j := journal{Entries: []journalEntry{{
+		Kind:     ComponentType,
+		Module:   "blog",
+		Name:     "post",
+		Modified: []string{"x/blog/types/codec.go"},
+		Inserted: map[string][]string{"x/blog/types/codec.go": {sdkImport}},
+	}}}
+	require.NoError(t, j.save(appPath))
+
+	sm := xgenny.NewSourceModification()
+	sm.AppendInsertions(codecFile, "cdc.RegisterConcrete(&MsgCreateComment{})\n")
+	// the codec import was in the file before scaffolding
+	sm.AppendReused(codecFile, `sdk "github.com/cosmos/cosmos-sdk/types"`, `"github.com/cosmos/cosmos-sdk/codec"`)
+
+	// Act
+	err := s.record(ComponentType, "blog", "comment", sm)
+
+	// Assert
+	require.NoError(t, err)
+	j, err = loadJournal(appPath)
+	require.NoError(t, err)
+	require.Len(t, j.Entries, 2)
+	require.Equal(t, map[string][]string{
+		"x/blog/types/codec.go": {"cdc.RegisterConcrete(&MsgCreateComment{})\n", sdkImport},
+	}, j.Entries[1].Inserted)
+}
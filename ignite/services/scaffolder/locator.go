@@ -0,0 +1,160 @@
+package scaffolder
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xast"
+	"github.com/ignite/cli/ignite/templates/module"
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+// anchor locates in a Go file where the code of a missing placeholder must be inserted.
+type anchor struct {
+	find func(f *ast.File) []token.Pos
+
+	// closing is true when the anchor is the closing delimiter of a block,
+	// the code is then inserted at the end of the block.
+	closing bool
+}
+
+// beforeStmt inserts the code before the statement found.
+func beforeStmt(find func(f *ast.File) token.Pos) anchor {
+	return anchor{find: func(f *ast.File) []token.Pos {
+		return []token.Pos{find(f)}
+	}}
+}
+
+// atEnd inserts the code at the end of the blocks closed by the delimiters found.
+func atEnd(find func(f *ast.File) []token.Pos) anchor {
+	return anchor{find: find, closing: true}
+}
+
+func lastReturn(funcName string) anchor {
+	return beforeStmt(func(f *ast.File) token.Pos {
+		return xast.LastReturn(f, funcName)
+	})
+}
+
+func stmtCalling(funcName, callName string) anchor {
+	return beforeStmt(func(f *ast.File) token.Pos {
+		return xast.StmtCalling(f, funcName, callName)
+	})
+}
+
+func funcBodyEnd(funcName string) anchor {
+	return atEnd(func(f *ast.File) []token.Pos {
+		return []token.Pos{xast.FuncBodyEnd(f, funcName)}
+	})
+}
+
+func callArgsEnd(callNames ...string) anchor {
+	return atEnd(func(f *ast.File) (positions []token.Pos) {
+		for _, name := range callNames {
+			positions = append(positions, xast.CallArgsEnd(f, name)...)
+		}
+		return positions
+	})
+}
+
+func compositeLitEnd(varName string) anchor {
+	return atEnd(func(f *ast.File) []token.Pos {
+		return []token.Pos{xast.CompositeLitEnd(f, varName)}
+	})
+}
+
+func structFieldsEnd(typeName string) anchor {
+	return atEnd(func(f *ast.File) []token.Pos {
+		return []token.Pos{xast.StructFieldsEnd(f, typeName)}
+	})
+}
+
+// importsEnd inserts the code at the end of the imports of the files declaring funcName.
+func importsEnd(funcName string) anchor {
+	return atEnd(func(f *ast.File) []token.Pos {
+		if !xast.FuncBodyEnd(f, funcName).IsValid() {
+			return nil
+		}
+		return []token.Pos{xast.ImportsEnd(f)}
+	})
+}
+
+// placeholderAnchors lists by placeholder the anchors where the scaffolded code is inserted
+// when the placeholder was removed from a Go file. The anchors are tried in order, the same
+// placeholder can be used in different files.
+var placeholderAnchors = map[string][]anchor{
+	// app/app.go
+	module.PlaceholderSgAppModuleImport:      {importsEnd("New")},
+	module.PlaceholderSgAppModuleBasic:       {callArgsEnd("NewBasicManager")},
+	module.PlaceholderSgAppMaccPerms:         {compositeLitEnd("maccPerms")},
+	module.PlaceholderSgAppKeeperDeclaration: {structFieldsEnd("App")},
+	module.PlaceholderSgAppStoreKey:          {callArgsEnd("NewKVStoreKeys")},
+	module.PlaceholderSgAppKeeperDefinition:  {stmtCalling("New", "Seal"), stmtCalling("New", "NewManager")},
+	module.PlaceholderIBCAppRouter:           {stmtCalling("New", "SetRouter")},
+	module.PlaceholderSgAppAppModule:         {callArgsEnd("NewManager", "NewSimulationManager")},
+	module.PlaceholderSgAppBeginBlockers:     {callArgsEnd("SetOrderBeginBlockers")},
+	module.PlaceholderSgAppEndBlockers:       {callArgsEnd("SetOrderEndBlockers")},
+	module.PlaceholderSgAppInitGenesis:       {callArgsEnd("SetOrderInitGenesis")},
+	module.PlaceholderSgAppParamSubspace:     {lastReturn("initParamsKeeper")},
+	module.PlaceholderSgAppBeforeInitReturn:  {lastReturn("New")},
+
+	// x/{{moduleName}}/genesis.go
+	typed.PlaceholderGenesisModuleInit:   {funcBodyEnd("InitGenesis")},
+	typed.PlaceholderGenesisModuleExport: {lastReturn("ExportGenesis")},
+
+	// x/{{moduleName}}/client/cli/tx.go, x/{{moduleName}}/client/cli/query.go
+	// and x/{{moduleName}}/types/codec.go
+	module.Placeholder: {
+		lastReturn("GetTxCmd"),
+		lastReturn("GetQueryCmd"),
+		importsEnd("RegisterCodec"),
+	},
+	module.Placeholder2: {funcBodyEnd("RegisterCodec")},
+	module.Placeholder3: {
+		stmtCalling("RegisterInterfaces", "RegisterMsgServiceDesc"),
+		funcBodyEnd("RegisterInterfaces"),
+	},
+}
+
+// LocatePlaceholder locates with the AST of a Go file where a missing scaffolding placeholder
+// would be, so the code can be scaffolded even if the placeholder was removed.
+func LocatePlaceholder(content, placeholderText string) []placeholder.Location {
+	anchors, ok := placeholderAnchors[placeholderText]
+	if !ok {
+		return nil
+	}
+
+	fileSet := token.NewFileSet()
+	f, err := parser.ParseFile(fileSet, "", content, 0)
+	if err != nil {
+		return nil
+	}
+
+	for _, a := range anchors {
+		var locations []placeholder.Location
+		for _, pos := range a.find(f) {
+			if !pos.IsValid() {
+				continue
+			}
+
+			// The code is inserted in a new line, only anchors starting a line are usable
+			offset := fileSet.Position(pos).Offset
+			lineOffset := strings.LastIndex(content[:offset], "\n") + 1
+			indent := content[lineOffset:offset]
+			if strings.TrimSpace(indent) != "" {
+				continue
+			}
+			if a.closing {
+				indent += "\t"
+			}
+			locations = append(locations, placeholder.Location{Offset: lineOffset, Indent: indent})
+		}
+		if len(locations) > 0 {
+			return locations
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package scaffolder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/templates/module"
+	"github.com/ignite/cli/ignite/templates/typed"
+)
+
+func TestLocatePlaceholder(t *testing.T) {
+	const app = `package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var ModuleBasics = module.NewBasicManager(
+	auth.AppModuleBasic{},
+)
+
+type App struct {
+	mm *module.Manager
+}
+
+func New() *App {
+	app := &App{}
+	app.CapabilityKeeper.Seal()
+	app.mm = module.NewManager(
+		auth.NewAppModule(),
+	)
+	app.sm = module.NewSimulationManager(
+		auth.NewAppModule(),
+	)
+	return app
+}
+`
+	tests := []struct {
+		name        string
+		content     string
+		placeholder string
+		replacement string
+		all         bool
+		want        string
+		missing     bool
+	}{
+		{
+			name:        "module basic",
+			content:     app,
+			placeholder: module.PlaceholderSgAppModuleBasic,
+			replacement: "blog.AppModuleBasic{},\n" + module.PlaceholderSgAppModuleBasic,
+			want: `var ModuleBasics = module.NewBasicManager(
+	auth.AppModuleBasic{},
+	blog.AppModuleBasic{},
+)`,
+		},
+		{
+			name:        "keeper declaration",
+			content:     app,
+			placeholder: module.PlaceholderSgAppKeeperDeclaration,
+			replacement: "BlogKeeper keeper.Keeper\n" + module.PlaceholderSgAppKeeperDeclaration,
+			want:        "\tmm *module.Manager\n\tBlogKeeper keeper.Keeper\n}",
+		},
+		{
+			name:        "keeper definition",
+			content:     app,
+			placeholder: module.PlaceholderSgAppKeeperDefinition,
+			replacement: module.PlaceholderSgAppKeeperDefinition + "\napp.BlogKeeper = keeper.NewKeeper()",
+			want:        "\tapp.BlogKeeper = keeper.NewKeeper()\n\tapp.CapabilityKeeper.Seal()",
+		},
+		{
+			name:        "app modules",
+			content:     app,
+			placeholder: module.PlaceholderSgAppAppModule,
+			replacement: "blogModule,\n" + module.PlaceholderSgAppAppModule,
+			all:         true,
+			want: `	app.mm = module.NewManager(
+		auth.NewAppModule(),
+		blogModule,
+	)
+	app.sm = module.NewSimulationManager(
+		auth.NewAppModule(),
+		blogModule,
+	)`,
+		},
+		{
+			name: "genesis export",
+			content: `package blog
+
+func ExportGenesis() *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	return genesis
+}
+`,
+			placeholder: typed.PlaceholderGenesisModuleExport,
+			replacement: "genesis.PostList = k.GetAllPost(ctx)\n" + typed.PlaceholderGenesisModuleExport,
+			want:        "\tgenesis.PostList = k.GetAllPost(ctx)\n\treturn genesis",
+		},
+		{
+			name: "tx command",
+			content: `package cli
+
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{}
+	return cmd
+}
+`,
+			placeholder: module.Placeholder,
+			replacement: "cmd.AddCommand(CmdCreatePost())\n" + module.Placeholder,
+			want:        "\tcmd.AddCommand(CmdCreatePost())\n\treturn cmd",
+		},
+		{
+			name: "codec",
+			content: `package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+}
+`,
+			placeholder: module.Placeholder2,
+			replacement: module.Placeholder2 + "\ncdc.RegisterConcrete(&MsgCreatePost{}, \"blog/CreatePost\", nil)",
+			want:        "{\n\tcdc.RegisterConcrete(&MsgCreatePost{}, \"blog/CreatePost\", nil)\n}",
+		},
+		{
+			name: "codec import",
+			content: `package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+}
+`,
+			placeholder: module.Placeholder,
+			replacement: module.Placeholder + "\nsdk \"github.com/cosmos/cosmos-sdk/types\"",
+			want:        "\t\"github.com/cosmos/cosmos-sdk/codec\"\n\tsdk \"github.com/cosmos/cosmos-sdk/types\"\n)",
+		},
+		{
+			name: "anchor not found",
+			content: `package types
+
+func RegisterInterfaces() {}
+`,
+			placeholder: module.Placeholder,
+			replacement: module.Placeholder + "\nfoo()",
+			missing:     true,
+		},
+		{
+			name:        "not a go file",
+			content:     "syntax = \"proto3\";\n",
+			placeholder: module.Placeholder2,
+			replacement: module.Placeholder2 + "\nstring foo = 1;",
+			missing:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tracer := placeholder.New(placeholder.WithLocator(LocatePlaceholder))
+
+			// Act
+			var content string
+			if tt.all {
+				content = tracer.ReplaceAll(tt.content, tt.placeholder, tt.replacement)
+			} else {
+				content = tracer.Replace(tt.content, tt.placeholder, tt.replacement)
+			}
+
+			// Assert
+			if tt.missing {
+				require.Error(t, tracer.Err())
+				require.Equal(t, tt.content, content)
+				return
+			}
+			require.NoError(t, tracer.Err())
+			require.Contains(t, content, tt.want)
+			require.NotContains(t, content, tt.placeholder)
+		})
+	}
+}
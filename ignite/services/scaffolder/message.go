@@ -108,6 +108,15 @@ func (s Scaffolder) AddMessage(
 	if err != nil {
 		return sm, err
 	}
+	if containConstraints(parsedResFields) {
+		return sm, fmt.Errorf("response fields can't contain field constraints")
+	}
+
+	parsedMsgFields.SetEnumPrefix(name.UpperCamel)
+	parsedResFields.SetEnumPrefix(name.UpperCamel + "Response")
+	if err := checkEnumsCreated(s.path, moduleName, parsedMsgFields, parsedResFields); err != nil {
+		return sm, err
+	}
 
 	mfSigner, err := multiformatname.NewName(scaffoldingOpts.signer)
 	if err != nil {
@@ -163,11 +172,14 @@ func (s Scaffolder) AddMessage(
 		return sm, err
 	}
 	gens = append(gens, g)
-	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	sm, err = s.run(tracer, gens...)
 	if err != nil {
 		return sm, err
 	}
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	if err := s.record(ComponentMessage, moduleName, name.LowerCamel, sm); err != nil {
+		return sm, err
+	}
+	return sm, s.finish(ctx, cacheStorage, opts.AppPath)
 }
 
 // checkForbiddenMessageField returns true if the name is forbidden as a message name
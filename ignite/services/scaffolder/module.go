@@ -183,6 +183,9 @@ func (s Scaffolder) CreateModule(
 	if err != nil {
 		return sm, err
 	}
+	if containConstraints(params) {
+		return sm, fmt.Errorf("params can't contain field constraints")
+	}
 
 	// Check dependencies
 	if err := checkDependencies(creationOpts.dependencies, s.path); err != nil {
@@ -214,20 +217,23 @@ func (s Scaffolder) CreateModule(
 		}
 		gens = append(gens, g)
 	}
-	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	sm, err = s.run(tracer, gens...)
 	if err != nil {
 		return sm, err
 	}
 
 	// Modify app.go to register the module
-	newSourceModification, runErr := xgenny.RunWithValidation(tracer, modulecreate.NewAppModify(tracer, opts))
+	newSourceModification, runErr := s.run(tracer, modulecreate.NewAppModify(tracer, opts))
 	sm.Merge(newSourceModification)
 	var validationErr validation.Error
 	if runErr != nil && !errors.As(runErr, &validationErr) {
 		return sm, runErr
 	}
+	if err := s.record(ComponentModule, moduleName, moduleName, sm); err != nil {
+		return sm, err
+	}
 
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	return sm, s.finish(ctx, cacheStorage, opts.AppPath)
 }
 
 // ImportModule imports specified module with name to the scaffolded app.
@@ -261,7 +267,7 @@ func (s Scaffolder) ImportModule(
 		return sm, err
 	}
 
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	sm, err = s.run(tracer, g)
 	if err != nil {
 		var validationErr validation.Error
 		if errors.As(err, &validationErr) {
@@ -277,7 +283,7 @@ func (s Scaffolder) ImportModule(
 		return sm, err
 	}
 
-	return sm, finish(ctx, cacheStorage, s.path, s.modpath.RawPath)
+	return sm, s.finish(ctx, cacheStorage, s.path)
 }
 
 // moduleExists checks if the module exists in the app
@@ -364,6 +370,8 @@ func isWasmImported(appPath string) (bool, error) {
 
 func (s Scaffolder) installWasm() error {
 	switch {
+	case s.dryRun != nil:
+		return nil
 	case s.Version.GTE(cosmosver.StargateFortyVersion):
 		return cmdrunner.
 			New().
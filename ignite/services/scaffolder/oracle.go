@@ -111,15 +111,18 @@ func (s *Scaffolder) AddOracle(
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	sm, err = s.run(tracer, g)
 	if err != nil {
 		return sm, err
 	}
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	return sm, s.finish(ctx, cacheStorage, opts.AppPath)
 }
 
 // Deprecated: This function is no longer maintained
 func (s Scaffolder) installBandPacket() error {
+	if s.dryRun != nil {
+		return nil
+	}
 	return cmdrunner.New().
 		Run(context.Background(),
 			step.New(step.Exec(gocmd.Name(), "get", gocmd.PackageLiteral(bandImport, bandVersion))),
@@ -102,6 +102,11 @@ func (s Scaffolder) AddPacket(
 		signer = o.signer
 	}
 
+	// Enums can only be defined with messages and types
+	if containEnumTypes(packetFields) || containEnumTypes(ackFields) {
+		return sm, fmt.Errorf("packet fields can't contain enum type")
+	}
+
 	// Check and parse packet fields
 	if err := checkCustomTypes(ctx, s.path, s.modpath.Package, moduleName, packetFields); err != nil {
 		return sm, err
@@ -120,6 +125,11 @@ func (s Scaffolder) AddPacket(
 		return sm, err
 	}
 
+	// Constraints are only validated by messages
+	if containConstraints(parsedPacketFields) || containConstraints(parsedAcksFields) {
+		return sm, fmt.Errorf("packet fields can't contain field constraints")
+	}
+
 	// Generate the packet
 	var (
 		g    *genny.Generator
@@ -139,11 +149,14 @@ func (s Scaffolder) AddPacket(
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	sm, err = s.run(tracer, g)
 	if err != nil {
 		return sm, err
 	}
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	if err := s.record(ComponentPacket, moduleName, name.LowerCamel, sm); err != nil {
+		return sm, err
+	}
+	return sm, s.finish(ctx, cacheStorage, opts.AppPath)
 }
 
 // isIBCModule returns true if the provided module implements the IBC module interface
@@ -45,6 +45,11 @@ func (s Scaffolder) AddQuery(
 		return sm, err
 	}
 
+	// Enums can only be defined with messages and types
+	if containEnumTypes(reqFields) || containEnumTypes(resFields) {
+		return sm, errors.New("query params can't contain enum type")
+	}
+
 	// Check and parse provided request fields
 	if ok := containCustomTypes(reqFields); ok {
 		return sm, errors.New("query request params can't contain custom type")
@@ -63,6 +68,11 @@ func (s Scaffolder) AddQuery(
 		return sm, err
 	}
 
+	// Constraints are only validated by messages
+	if containConstraints(parsedReqFields) || containConstraints(parsedResFields) {
+		return sm, errors.New("query params can't contain field constraints")
+	}
+
 	var (
 		g    *genny.Generator
 		opts = &query.Options{
@@ -83,9 +93,12 @@ func (s Scaffolder) AddQuery(
 	if err != nil {
 		return sm, err
 	}
-	sm, err = xgenny.RunWithValidation(tracer, g)
+	sm, err = s.run(tracer, g)
 	if err != nil {
 		return sm, err
 	}
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	if err := s.record(ComponentQuery, moduleName, name.LowerCamel, sm); err != nil {
+		return sm, err
+	}
+	return sm, s.finish(ctx, cacheStorage, opts.AppPath)
 }
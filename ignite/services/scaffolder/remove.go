@@ -0,0 +1,280 @@
+package scaffolder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/ignite/cli/ignite/pkg/cache"
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+// RemoveOption configures options for Remove.
+type RemoveOption func(*removeOptions)
+
+type removeOptions struct {
+	moduleName string
+}
+
+// RemoveWithModule sets the module of the component to remove.
+func RemoveWithModule(name string) RemoveOption {
+	return func(o *removeOptions) {
+		o.moduleName = name
+	}
+}
+
+// SnippetsNotRemovedError is returned when some inserted snippets can't be found anymore
+// in the modified files, they must be removed manually.
+type SnippetsNotRemovedError struct {
+	// Snippets are the snippets not removed by file.
+	Snippets map[string][]string
+}
+
+func (e SnippetsNotRemovedError) Error() string {
+	var b strings.Builder
+	b.WriteString("some scaffolded code couldn't be found and must be removed manually:")
+	for file, snippets := range e.Snippets {
+		for _, snippet := range snippets {
+			fmt.Fprintf(&b, "\n%s:\n%s", file, strings.TrimSpace(snippet))
+		}
+	}
+	return b.String()
+}
+
+// Remove reverts the scaffolding of a component recorded in the journal of the app.
+// The files created by the component are deleted, the code it inserted is removed from
+// the modified files, and the proto files are generated again.
+// Removing a module also removes the components scaffolded inside of it.
+func (s Scaffolder) Remove(
+	ctx context.Context,
+	cacheStorage cache.Storage,
+	kind,
+	name string,
+	options ...RemoveOption,
+) (sm xgenny.SourceModification, err error) {
+	sm = xgenny.NewSourceModification()
+
+	o := removeOptions{moduleName: s.modpath.Package}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	mfModuleName, err := multiformatname.NewName(o.moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName := mfModuleName.LowerCase
+
+	mfName, err := multiformatname.NewName(name)
+	if err != nil {
+		return sm, err
+	}
+	if kind == ComponentModule {
+		moduleName = mfName.LowerCase
+	}
+
+	j, err := loadJournal(s.path)
+	if err != nil {
+		return sm, err
+	}
+
+	// Select the entries to remove, the latest component first
+	var removed, remaining []journalEntry
+	found := false
+	for i := len(j.Entries) - 1; i >= 0; i-- {
+		entry := j.Entries[i]
+		switch {
+		case kind == ComponentModule && entry.Module == moduleName:
+			removed = append(removed, entry)
+			found = found || entry.Kind == ComponentModule
+		case !found && entry.Kind == kind && entry.Module == moduleName && entry.Name == mfName.LowerCamel:
+			removed = append(removed, entry)
+			found = true
+		default:
+			remaining = append([]journalEntry{entry}, remaining...)
+		}
+	}
+	if !found {
+		return sm, fmt.Errorf("no %s %s scaffolded in the module %s was found in %s", kind, name, moduleName, journalPath)
+	}
+
+	notRemoved := make(map[string][]string)
+	for _, entry := range removed {
+		if err := s.removeEntry(entry, remaining, &sm, notRemoved); err != nil {
+			return sm, err
+		}
+	}
+
+	if s.dryRun == nil {
+		j.Entries = remaining
+		if err := j.save(s.path); err != nil {
+			return sm, err
+		}
+	}
+
+	if err := s.finish(ctx, cacheStorage, s.path); err != nil {
+		return sm, err
+	}
+	if len(notRemoved) > 0 {
+		return sm, SnippetsNotRemovedError{Snippets: notRemoved}
+	}
+	return sm, nil
+}
+
+// removeEntry reverts the source modification of a journal entry. The files and snippets
+// still used by the remaining entries are kept.
+func (s Scaffolder) removeEntry(
+	entry journalEntry,
+	remaining []journalEntry,
+	sm *xgenny.SourceModification,
+	notRemoved map[string][]string,
+) error {
+	usedByRemaining := func(file string) bool {
+		for _, e := range remaining {
+			if e.uses(file) {
+				return true
+			}
+		}
+		return false
+	}
+	insertedByRemaining := func(file, snippet string) bool {
+		for _, e := range remaining {
+			for _, inserted := range e.Inserted[file] {
+				if inserted == snippet {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	// Strip the inserted snippets from the modified files
+	for file, snippets := range entry.Inserted {
+		path := filepath.Join(s.path, file)
+		content, err := s.readFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		newContent := string(content)
+		for _, snippet := range snippets {
+			if insertedByRemaining(file, snippet) {
+				continue
+			}
+			var ok bool
+			if newContent, ok = removeSnippet(newContent, snippet); !ok {
+				notRemoved[file] = append(notRemoved[file], snippet)
+			}
+		}
+		if newContent == string(content) {
+			continue
+		}
+		if err := s.writeFile(path, newContent); err != nil {
+			return err
+		}
+		sm.AppendModifiedFiles(path)
+	}
+
+	// Delete the created files with the code generated from the created proto files
+	var files []string
+	for _, file := range entry.Created {
+		files = append(files, file)
+		if filepath.Ext(file) == ".proto" {
+			base := strings.TrimSuffix(filepath.Base(file), ".proto")
+			files = append(files,
+				filepath.Join("x", entry.Module, "types", base+".pb.go"),
+				filepath.Join("x", entry.Module, "types", base+".pb.gw.go"),
+			)
+		}
+	}
+	for _, file := range files {
+		if usedByRemaining(file) {
+			continue
+		}
+		path := filepath.Join(s.path, file)
+		if err := s.removeFile(path); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+		sm.AppendRemovedFiles(path)
+
+		// Remove the directories left empty
+		if s.dryRun != nil {
+			continue
+		}
+		for dir := filepath.Dir(path); dir != s.path; dir = filepath.Dir(dir) {
+			if err := os.Remove(dir); err != nil {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// readFile reads a file of the app, as modified by the previous dry runs in dry run mode.
+func (s Scaffolder) readFile(path string) ([]byte, error) {
+	if s.dryRun != nil {
+		return s.dryRun.ReadFile(path)
+	}
+	return os.ReadFile(path)
+}
+
+// writeFile writes a file of the app, the disk is left untouched in dry run mode.
+func (s Scaffolder) writeFile(path, content string) error {
+	if s.dryRun != nil {
+		return s.dryRun.WriteFile(path, content)
+	}
+	return os.WriteFile(path, []byte(content), 0o644)
+}
+
+// removeFile removes a file of the app, the disk is left untouched in dry run mode.
+func (s Scaffolder) removeFile(path string) error {
+	if s.dryRun != nil {
+		return s.dryRun.RemoveFile(path)
+	}
+	return os.Remove(path)
+}
+
+// removeSnippet removes the first occurrence of a snippet from the content. Since the
+// source code is formatted after scaffolding, the whitespaces of the snippet are
+// matched loosely when the snippet can't be found as is.
+func removeSnippet(content, snippet string) (string, bool) {
+	if i := strings.Index(content, snippet); i >= 0 {
+		return content[:i] + content[i+len(snippet):], true
+	}
+
+	tokens := strings.Fields(snippet)
+	if len(tokens) == 0 {
+		return content, false
+	}
+	for i, token := range tokens {
+		tokens[i] = regexp.QuoteMeta(token)
+	}
+
+	// Keep the line breaks around the snippet
+	leading, trailing := `[ \t]*`, `[ \t]*`
+	if strings.ContainsRune(snippet[:len(snippet)-len(strings.TrimLeftFunc(snippet, unicode.IsSpace))], '\n') {
+		leading = `\n` + leading
+	}
+	if strings.ContainsRune(snippet[len(strings.TrimRightFunc(snippet, unicode.IsSpace)):], '\n') {
+		trailing += `\n`
+	}
+
+	re := regexp.MustCompile(leading + strings.Join(tokens, `\s*`) + trailing)
+	loc := re.FindStringIndex(content)
+	if loc == nil {
+		return content, false
+	}
+	return content[:loc[0]] + content[loc[1]:], true
+}
@@ -0,0 +1,155 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/xgenny"
+)
+
+func TestRemoveSnippet(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		snippet string
+		want    string
+		removed bool
+	}{
+		{
+			name:    "exact snippet",
+			content: "a\nfoo()\n// placeholder\n",
+			snippet: "foo()\n",
+			want:    "a\n// placeholder\n",
+			removed: true,
+		},
+		{
+			name:    "formatted snippet",
+			content: "\tfoo := Foo{\n\t\tA:   1,\n\t\tBcd: 2,\n\t}\n\t// placeholder\n",
+			snippet: "foo := Foo{\n  A: 1,\n  Bcd: 2,\n}\n",
+			want:    "\t// placeholder\n",
+			removed: true,
+		},
+		{
+			name:    "first occurrence only",
+			content: "foo()\nfoo()\n",
+			snippet: "foo()\n",
+			want:    "foo()\n",
+			removed: true,
+		},
+		{
+			name:    "edited snippet",
+			content: "bar()\n",
+			snippet: "foo()\n",
+			want:    "bar()\n",
+			removed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, removed := removeSnippet(tt.content, tt.snippet)
+			require.Equal(t, tt.removed, removed)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRemoveEntry(t *testing.T) {
+	// Arrange
+	var (
+		appPath = t.TempDir()
+		s       = Scaffolder{path: appPath}
+		sm      = xgenny.NewSourceModification()
+		write   = func(name, content string) {
+			path := filepath.Join(appPath, name)
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+			require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+		}
+	)
+	write("app/app.go", "import \"bar\"\nimport \"foo\"\n// placeholder\n")
+	write("proto/blog/blog/post.proto", "message Post {}")
+	write("x/blog/types/post.pb.go", "package types")
+	write("x/blog/types/handler.go", "package types")
+
+	entry := journalEntry{
+		Kind:     ComponentType,
+		Module:   "blog",
+		Name:     "post",
+		Created:  []string{"proto/blog/blog/post.proto", "x/blog/types/handler.go"},
+		Modified: []string{"app/app.go"},
+		Inserted: map[string][]string{"app/app.go": {"import \"bar\"\n", "import \"foo\"\n"}},
+	}
+	remaining := []journalEntry{{
+		Kind:     ComponentMessage,
+		Module:   "blog",
+		Name:     "create-post",
+		Modified: []string{"app/app.go", "x/blog/types/handler.go"},
+		Inserted: map[string][]string{"app/app.go": {"import \"bar\"\n"}},
+	}}
+	notRemoved := make(map[string][]string)
+
+	// Act
+	err := s.removeEntry(entry, remaining, &sm, notRemoved)
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, notRemoved)
+
+	content, err := os.ReadFile(filepath.Join(appPath, "app/app.go"))
+	require.NoError(t, err)
+	require.Equal(t, "import \"bar\"\n// placeholder\n", string(content))
+
+	require.NoDirExists(t, filepath.Join(appPath, "proto"))
+	require.NoFileExists(t, filepath.Join(appPath, "x/blog/types/post.pb.go"))
+	require.FileExists(t, filepath.Join(appPath, "x/blog/types/handler.go"))
+	require.ElementsMatch(t, []string{
+		filepath.Join(appPath, "proto/blog/blog/post.proto"),
+		filepath.Join(appPath, "x/blog/types/post.pb.go"),
+	}, sm.RemovedFiles())
+	require.Equal(t, []string{filepath.Join(appPath, "app/app.go")}, sm.ModifiedFiles())
+}
+
+func TestRemoveEntryDryRun(t *testing.T) {
+	// Arrange
+	var (
+		appPath = t.TempDir()
+		dryRun  = xgenny.NewDryRun()
+		s       = Scaffolder{path: appPath, dryRun: dryRun}
+		sm      = xgenny.NewSourceModification()
+		appFile = filepath.Join(appPath, "app/app.go")
+		created = filepath.Join(appPath, "x/blog/types/handler.go")
+	)
+	require.NoError(t, os.MkdirAll(filepath.Dir(appFile), 0o755))
+	require.NoError(t, os.WriteFile(appFile, []byte("import \"foo\"\n// placeholder\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Dir(created), 0o755))
+	require.NoError(t, os.WriteFile(created, []byte("package types"), 0o644))
+
+	entry := journalEntry{
+		Kind:     ComponentType,
+		Module:   "blog",
+		Name:     "post",
+		Created:  []string{"x/blog/types/handler.go"},
+		Modified: []string{"app/app.go"},
+		Inserted: map[string][]string{"app/app.go": {"import \"foo\"\n"}},
+	}
+
+	// Act
+	err := s.removeEntry(entry, nil, &sm, make(map[string][]string))
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{appFile, created}, dryRun.Files())
+	content, ok := dryRun.Content(appFile)
+	require.True(t, ok)
+	require.Equal(t, "// placeholder\n", content)
+	_, ok = dryRun.Content(created)
+	require.False(t, ok)
+
+	// the disk is left untouched
+	require.FileExists(t, created)
+	current, err := os.ReadFile(appFile)
+	require.NoError(t, err)
+	require.Equal(t, "import \"foo\"\n// placeholder\n", string(current))
+}
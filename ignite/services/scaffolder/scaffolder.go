@@ -6,6 +6,8 @@ import (
 	"context"
 	"path/filepath"
 
+	"github.com/gobuffalo/genny"
+
 	"github.com/ignite/cli/ignite/chainconfig"
 	"github.com/ignite/cli/ignite/pkg/cache"
 	"github.com/ignite/cli/ignite/pkg/cosmosanalysis"
@@ -14,6 +16,8 @@ import (
 	"github.com/ignite/cli/ignite/pkg/gocmd"
 	"github.com/ignite/cli/ignite/pkg/gomodule"
 	"github.com/ignite/cli/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/ignite/pkg/placeholder"
+	"github.com/ignite/cli/ignite/pkg/xgenny"
 )
 
 // Scaffolder is Ignite CLI app scaffolder.
@@ -26,10 +30,25 @@ type Scaffolder struct {
 
 	// modpath represents the go module path of the app.
 	modpath gomodulepath.Path
+
+	// dryRun keeps the source modifications in memory when set.
+	dryRun *xgenny.DryRun
+}
+
+// Option configures the scaffolder.
+type Option func(*Scaffolder)
+
+// WithDryRun runs the generators against the in-memory file system of dryRun instead
+// of the disk. The app is left untouched and the post scaffolding steps, like the
+// proto files generation, are skipped.
+func WithDryRun(dryRun *xgenny.DryRun) Option {
+	return func(s *Scaffolder) {
+		s.dryRun = dryRun
+	}
 }
 
 // App creates a new scaffolder for an existent app.
-func App(path string) (Scaffolder, error) {
+func App(path string, options ...Option) (Scaffolder, error) {
 	path, err := filepath.Abs(path)
 	if err != nil {
 		return Scaffolder{}, err
@@ -57,10 +76,29 @@ func App(path string) (Scaffolder, error) {
 		path:    path,
 		modpath: modpath,
 	}
+	for _, apply := range options {
+		apply(&s)
+	}
 
 	return s, nil
 }
 
+// run runs the generators against the app, or against the in-memory file system in dry run mode.
+func (s Scaffolder) run(tracer *placeholder.Tracer, gens ...*genny.Generator) (xgenny.SourceModification, error) {
+	if s.dryRun != nil {
+		return xgenny.DryRunWithValidation(tracer, s.dryRun, gens...)
+	}
+	return xgenny.RunWithValidation(tracer, gens...)
+}
+
+// finish runs the post scaffolding steps on the app, they are skipped in dry run mode.
+func (s Scaffolder) finish(ctx context.Context, cacheStorage cache.Storage, path string) error {
+	if s.dryRun != nil {
+		return nil
+	}
+	return finish(ctx, cacheStorage, path, s.modpath.RawPath)
+}
+
 func finish(ctx context.Context, cacheStorage cache.Storage, path, gomodPath string) error {
 	if err := protoc(ctx, cacheStorage, path, gomodPath); err != nil {
 		return err
@@ -80,7 +118,9 @@ func protoc(ctx context.Context, cacheStorage cache.Storage, projectPath, gomodP
 	if err != nil {
 		return err
 	}
-	conf, err := chainconfig.ParseFile(confpath)
+	// The config values aren't expanded because only the proto and client paths are
+	// used, scaffolding must not require the environment variables of the chain
+	conf, err := chainconfig.ParseFileUnexpanded(confpath)
 	if err != nil {
 		return err
 	}
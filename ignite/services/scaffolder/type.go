@@ -159,6 +159,10 @@ func (s Scaffolder) AddType(
 	if err != nil {
 		return sm, err
 	}
+	tFields.SetEnumPrefix(name.UpperCamel)
+	if err := checkEnumsCreated(s.path, moduleName, tFields); err != nil {
+		return sm, err
+	}
 
 	mfSigner, err := multiformatname.NewName(o.signer)
 	if err != nil {
@@ -240,12 +244,15 @@ func (s Scaffolder) AddType(
 
 	// run the generation
 	gens = append(gens, g)
-	sm, err = xgenny.RunWithValidation(tracer, gens...)
+	sm, err = s.run(tracer, gens...)
 	if err != nil {
 		return sm, err
 	}
+	if err := s.record(ComponentType, moduleName, name.LowerCamel, sm); err != nil {
+		return sm, err
+	}
 
-	return sm, finish(ctx, cacheStorage, opts.AppPath, s.modpath.RawPath)
+	return sm, s.finish(ctx, cacheStorage, opts.AppPath)
 }
 
 // checkForbiddenTypeIndex returns true if the name is forbidden as a field name
@@ -287,6 +294,9 @@ func mapGenerator(replacer placeholder.Replacer, opts *typed.Options, indexes []
 	if err != nil {
 		return nil, err
 	}
+	if containConstraints(parsedIndexes) {
+		return nil, fmt.Errorf("indexes can't contain field constraints")
+	}
 
 	// Indexes and type fields must be disjoint
 	exists := make(map[string]struct{})
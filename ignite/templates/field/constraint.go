@@ -0,0 +1,531 @@
+package field
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/ignite/templates/field/datatype"
+)
+
+const (
+	// ConstraintRequired requires a non empty value
+	ConstraintRequired = "required"
+	// ConstraintMin sets the minimum value of a number or the minimum length of a text or an array
+	ConstraintMin = "min"
+	// ConstraintMax sets the maximum value of a number or the maximum length of a text or an array
+	ConstraintMax = "max"
+	// ConstraintSigner requires an address to be the message signer
+	ConstraintSigner = "signer"
+	// ConstraintUnique requires the values of an array to be unique
+	ConstraintUnique = "unique"
+
+	// ConstraintSeparator separates the constraints of a field, like in "title:string:required,max=64"
+	ConstraintSeparator = ","
+	// ConstraintValueSeparator separates a constraint name from its value, like in "max=64"
+	ConstraintValueSeparator = "="
+
+	// MaxConstraintLength is the greatest length accepted by the min and max constraints of a text
+	// or an array, the generated tests contain literal values of these lengths.
+	MaxConstraintLength = 4096
+)
+
+// Constraints are the optional validation constraints of a field.
+type Constraints struct {
+	Required bool
+	Min      *int64
+	Max      *int64
+	Signer   bool
+	Unique   bool
+}
+
+// constraintKind groups the data types by the constraints they support.
+type constraintKind int
+
+const (
+	kindNone constraintKind = iota
+	kindText
+	kindList
+	kindNumber
+	kindAddress
+	kindValue
+)
+
+func (f Field) constraintKind() constraintKind {
+	switch f.DatatypeName {
+	case datatype.String, datatype.Bytes:
+		return kindText
+	case datatype.StringSlice, datatype.StringSliceAlias,
+		datatype.IntSlice, datatype.IntSliceAlias,
+		datatype.UintSlice, datatype.UintSliceAlias,
+		datatype.Coins, datatype.CoinSliceAlias:
+		return kindList
+	case datatype.Int, datatype.Uint, datatype.Decimal:
+		return kindNumber
+	case datatype.Address:
+		return kindAddress
+	case datatype.Coin, datatype.Timestamp:
+		return kindValue
+	}
+	return kindNone
+}
+
+// isCoins checks if the field is an array of coins.
+func (f Field) isCoins() bool {
+	return f.DatatypeName == datatype.Coins || f.DatatypeName == datatype.CoinSliceAlias
+}
+
+// numberRange returns the range of the values of the Go type of a number field.
+func (f Field) numberRange() (lo, hi int64) {
+	switch f.DatatypeName {
+	case datatype.Int:
+		return math.MinInt32, math.MaxInt32
+	case datatype.Uint:
+		return 0, math.MaxInt64
+	}
+	return math.MinInt64, math.MaxInt64
+}
+
+// parseConstraints parses the constraints of a field, like "required,max=64".
+func parseConstraints(f Field, spec string) (c Constraints, err error) {
+	kind := f.constraintKind()
+	for _, constraint := range strings.Split(spec, ConstraintSeparator) {
+		name, value, hasValue := strings.Cut(constraint, ConstraintValueSeparator)
+
+		var supported bool
+		switch name {
+		case ConstraintRequired:
+			supported = kind == kindText || kind == kindList || kind == kindAddress || kind == kindValue
+			c.Required = true
+		case ConstraintSigner:
+			supported = kind == kindAddress
+			c.Signer = true
+		case ConstraintUnique:
+			supported = kind == kindList && !f.isCoins()
+			c.Unique = true
+		case ConstraintMin, ConstraintMax:
+			supported = kind == kindText || kind == kindList || kind == kindNumber
+		default:
+			return c, fmt.Errorf("unknown constraint %q for the field %s", name, f.Name.Original)
+		}
+
+		if !supported {
+			return c, fmt.Errorf("the constraint %s is not supported by the %s type of the field %s", name, f.DatatypeName, f.Name.Original)
+		}
+
+		if name != ConstraintMin && name != ConstraintMax {
+			if hasValue {
+				return c, fmt.Errorf("the constraint %s of the field %s doesn't have a value", name, f.Name.Original)
+			}
+			continue
+		}
+
+		if !hasValue {
+			return c, fmt.Errorf("the constraint %s of the field %s requires a value, like '%s=1'", name, f.Name.Original, name)
+		}
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return c, fmt.Errorf("invalid %s value %q for the field %s: %w", name, value, f.Name.Original, err)
+		}
+
+		// Only the signed numbers accept negative bounds
+		canBeNegative := kind == kindNumber && f.DatatypeName != datatype.Uint
+		if n < 0 && !canBeNegative {
+			return c, fmt.Errorf("the %s value of the field %s can't be negative", name, f.Name.Original)
+		}
+		if lo, hi := f.numberRange(); kind == kindNumber && (n < lo || n > hi) {
+			return c, fmt.Errorf("the %s value of the field %s is out of the %s range [%d, %d]",
+				name, f.Name.Original, f.DataType(), lo, hi)
+		}
+		if kind != kindNumber && n > MaxConstraintLength {
+			return c, fmt.Errorf("the %s value of the field %s is greater than the maximum length %d",
+				name, f.Name.Original, MaxConstraintLength)
+		}
+		if name == ConstraintMin {
+			c.Min = &n
+		} else {
+			c.Max = &n
+		}
+	}
+
+	if c.Min != nil && c.Max != nil && *c.Min > *c.Max {
+		return c, fmt.Errorf("the min value of the field %s is greater than the max value", f.Name.Original)
+	}
+	if c.Required && c.Max != nil && *c.Max == 0 {
+		return c, fmt.Errorf("the required field %s can't have a max value of 0", f.Name.Original)
+	}
+	return c, nil
+}
+
+// HasConstraints checks if the field has at least one constraint.
+func (f Field) HasConstraints() bool {
+	c := f.Constraints
+	return c.Required || c.Min != nil || c.Max != nil || c.Signer || c.Unique
+}
+
+// bounds returns the inclusive range of the valid values of a number, or
+// of the valid lengths of a text or an array.
+func (f Field) bounds() (lo, hi int64) {
+	c := f.Constraints
+	if c.Min != nil {
+		lo = *c.Min
+	} else if f.constraintKind() == kindNumber && c.Max != nil && *c.Max < 0 {
+		lo = *c.Max
+	}
+	if c.Required && lo < 1 {
+		lo = 1
+	}
+
+	hi = lo + 10
+	if f.constraintKind() == kindNumber {
+		hi = lo + 100
+		// The default range must not exceed the values of the type
+		if _, typeMax := f.numberRange(); lo > typeMax-100 {
+			hi = typeMax
+		}
+	}
+	if c.Max != nil {
+		hi = *c.Max
+	}
+	return lo, hi
+}
+
+// unit returns the name of the elements counted by the length constraints.
+func (f Field) unit() string {
+	switch {
+	case f.DatatypeName == datatype.String:
+		return "characters"
+	case f.DatatypeName == datatype.Bytes:
+		return "bytes"
+	}
+	return "items"
+}
+
+// elemType returns the Go type of the elements of an array field.
+func (f Field) elemType() string {
+	return strings.TrimPrefix(f.DataType(), "[]")
+}
+
+// constraintChecks returns the ValidateBasic code that checks the constraints of the field.
+func (f Field) constraintChecks(signer multiformatname.Name) []string {
+	var (
+		c      = f.Constraints
+		field  = "msg." + f.Name.UpperCamel
+		name   = f.Name.LowerCamel
+		checks []string
+	)
+
+	check := func(cond, errType, msg string) {
+		checks = append(checks, fmt.Sprintf(`if %s {
+		return sdkerrors.Wrap(sdkerrors.%s, "%s")
+	}`, cond, errType, msg))
+	}
+
+	if c.Required {
+		switch f.DatatypeName {
+		case datatype.Coin:
+			check(fmt.Sprintf("%[1]s.Amount.IsNil() || %[1]s.IsZero()", field), "ErrInvalidRequest", name+" is required")
+		case datatype.Timestamp:
+			check(field+".IsZero()", "ErrInvalidRequest", name+" is required")
+		default:
+			check(fmt.Sprintf("len(%s) == 0", field), "ErrInvalidRequest", name+" is required")
+		}
+	}
+
+	for _, bound := range []struct {
+		value *int64
+		op    string
+		desc  string
+	}{
+		{c.Min, "<", "at least"},
+		{c.Max, ">", "at most"},
+	} {
+		if bound.value == nil {
+			continue
+		}
+		n := *bound.value
+
+		// A min value of 0 is always satisfied by lengths and unsigned numbers
+		if bound.op == "<" && n == 0 && (f.constraintKind() != kindNumber || f.DatatypeName == datatype.Uint) {
+			continue
+		}
+
+		switch {
+		case f.DatatypeName == datatype.Decimal && bound.op == "<":
+			check(fmt.Sprintf("%[1]s.IsNil() || %[1]s.LT(sdk.NewDec(%[2]d))", field, n),
+				"ErrInvalidRequest", fmt.Sprintf("%s must be %s %d", name, bound.desc, n))
+		case f.DatatypeName == datatype.Decimal:
+			check(fmt.Sprintf("!%[1]s.IsNil() && %[1]s.GT(sdk.NewDec(%[2]d))", field, n),
+				"ErrInvalidRequest", fmt.Sprintf("%s must be %s %d", name, bound.desc, n))
+		case f.constraintKind() == kindNumber:
+			check(fmt.Sprintf("%s %s %d", field, bound.op, n),
+				"ErrInvalidRequest", fmt.Sprintf("%s must be %s %d", name, bound.desc, n))
+		default:
+			check(fmt.Sprintf("len(%s) %s %d", field, bound.op, n),
+				"ErrInvalidRequest", fmt.Sprintf("%s must have %s %d %s", name, bound.desc, n, f.unit()))
+		}
+	}
+
+	if c.Unique {
+		checks = append(checks, fmt.Sprintf(`{
+		seen := make(map[%[2]s]struct{})
+		for _, v := range %[1]s {
+			if _, ok := seen[v]; ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%[3]s must not contain duplicates, found %%v", v)
+			}
+			seen[v] = struct{}{}
+		}
+	}`, field, f.elemType(), name))
+	}
+
+	if c.Signer {
+		check(fmt.Sprintf("%s != msg.%s", field, signer.UpperCamel),
+			"ErrUnauthorized", fmt.Sprintf("%s must be the %s", name, signer.LowerCamel))
+	}
+
+	return checks
+}
+
+// listValue returns a Go array value of a field with n unique elements.
+func (f Field) listValue(n int64) string {
+	elems := make([]string, n)
+	for i := range elems {
+		switch {
+		case f.isCoins():
+			elems[i] = fmt.Sprintf(`sdk.NewInt64Coin("token%d", 10)`, i)
+		case f.elemType() == "string":
+			elems[i] = fmt.Sprintf(`"a%d"`, i)
+		default:
+			elems[i] = strconv.Itoa(i)
+		}
+	}
+	return fmt.Sprintf("%s{%s}", f.DataType(), strings.Join(elems, ", "))
+}
+
+// lengthValue returns a Go value of a text or an array field with a length of n.
+func (f Field) lengthValue(n int64) string {
+	switch f.DatatypeName {
+	case datatype.String:
+		return strconv.Quote(strings.Repeat("a", int(n)))
+	case datatype.Bytes:
+		return fmt.Sprintf("[]byte(%s)", strconv.Quote(strings.Repeat("a", int(n))))
+	}
+	return f.listValue(n)
+}
+
+// numberValue returns a Go value of a number field.
+func (f Field) numberValue(n int64) string {
+	if f.DatatypeName == datatype.Decimal {
+		return fmt.Sprintf("sdk.NewDec(%d)", n)
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+// validTestValue returns a Go value of the field that satisfies its constraints.
+func (f Field) validTestValue(signer string) string {
+	lo, _ := f.bounds()
+	switch f.constraintKind() {
+	case kindText, kindList:
+		return f.lengthValue(lo)
+	case kindNumber:
+		return f.numberValue(lo)
+	case kindAddress:
+		if f.Constraints.Signer {
+			return signer
+		}
+		return "sample.AccAddress()"
+	}
+
+	if f.DatatypeName == datatype.Timestamp {
+		return "time.Unix(1, 0)"
+	}
+	return `sdk.NewInt64Coin("token", 10)`
+}
+
+// constrainedTestValue returns the CLI argument used in tests for a constrained
+// field, which is the default test value when it satisfies the constraints.
+func (f Field) constrainedTestValue(defaultValue string) string {
+	lo, hi := f.bounds()
+	inBounds := func(n int64) bool { return n >= lo && n <= hi }
+
+	switch f.constraintKind() {
+	case kindText:
+		if f.DatatypeName == datatype.Bytes {
+			if inBounds(int64(len(defaultValue) / 2)) {
+				return defaultValue
+			}
+			return strings.Repeat("61", int(lo))
+		}
+		if inBounds(int64(len(defaultValue))) {
+			return defaultValue
+		}
+		return strings.Repeat("a", int(lo))
+	case kindList:
+		if inBounds(int64(len(strings.Split(defaultValue, ",")))) {
+			return defaultValue
+		}
+		elems := make([]string, lo)
+		for i := range elems {
+			switch {
+			case f.isCoins():
+				elems[i] = fmt.Sprintf("10token%d", i)
+			case f.elemType() == "string":
+				elems[i] = fmt.Sprintf("a%d", i)
+			default:
+				elems[i] = strconv.Itoa(i)
+			}
+		}
+		return strings.Join(elems, ",")
+	case kindNumber:
+		if n, err := strconv.ParseFloat(defaultValue, 64); err == nil && n >= float64(lo) && n <= float64(hi) {
+			return defaultValue
+		}
+		// Negative arguments are parsed as flags by the CLI
+		if lo < 0 && hi >= 0 {
+			return "0"
+		}
+		return strconv.FormatInt(lo, 10)
+	}
+	return defaultValue
+}
+
+// SimValue returns a random Go value of the field that satisfies its constraints,
+// for the simulation of the messages, or an empty string if the field has no constraints.
+func (f Field) SimValue(signer string) string {
+	if !f.HasConstraints() {
+		return ""
+	}
+
+	// RandIntBetween excludes its max value, which must not overflow an int64
+	// with the size of the range either, so the highest values are excluded
+	// from the simulation when the range is too large.
+	lo, hi := f.bounds()
+	if uint64(hi)-uint64(lo) >= math.MaxInt64 {
+		hi = lo + math.MaxInt64 - 1
+	}
+	randInt := fmt.Sprintf("simtypes.RandIntBetween(r, %d, %d)", lo, hi+1)
+	switch f.DatatypeName {
+	case datatype.String:
+		return fmt.Sprintf("simtypes.RandStringOfLength(r, %s)", randInt)
+	case datatype.Bytes:
+		return fmt.Sprintf("[]byte(simtypes.RandStringOfLength(r, %s))", randInt)
+	case datatype.Int:
+		return fmt.Sprintf("int32(%s)", randInt)
+	case datatype.Uint:
+		return fmt.Sprintf("uint64(%s)", randInt)
+	case datatype.Decimal:
+		return fmt.Sprintf("sdk.NewDec(int64(%s))", randInt)
+	case datatype.Address:
+		return signer
+	case datatype.Timestamp:
+		return "ctx.BlockTime()"
+	}
+	return f.validTestValue(signer)
+}
+
+// ConstraintTestCase is a test case of the ValidateBasic method of a message
+// with a value that doesn't satisfy a field constraint.
+type ConstraintTestCase struct {
+	Name   string
+	Values []string
+	Err    string
+}
+
+// ValidTestValues returns the values of the constrained fields of a message that
+// satisfy their constraints, like "Title: \"a\"".
+func (f Fields) ValidTestValues(signer string) []string {
+	values := make([]string, 0)
+	for _, field := range f {
+		if field.HasConstraints() {
+			values = append(values, fmt.Sprintf("%s: %s", field.Name.UpperCamel, field.validTestValue(signer)))
+		}
+	}
+	return values
+}
+
+// ConstraintTestCases returns a test case for each field constraint of a message.
+func (f Fields) ConstraintTestCases(signer string) []ConstraintTestCase {
+	cases := make([]ConstraintTestCase, 0)
+	for i, field := range f {
+		if !field.HasConstraints() {
+			continue
+		}
+
+		// The other constrained fields have valid values
+		add := func(constraint, value, errType string) {
+			tc := ConstraintTestCase{
+				Name: fmt.Sprintf("invalid %s (%s)", field.Name.LowerCamel, constraint),
+				Err:  "sdkerrors." + errType,
+			}
+			for j, other := range f {
+				switch {
+				case j == i && value != "":
+					tc.Values = append(tc.Values, fmt.Sprintf("%s: %s", field.Name.UpperCamel, value))
+				case j != i && other.HasConstraints():
+					tc.Values = append(tc.Values, fmt.Sprintf("%s: %s", other.Name.UpperCamel, other.validTestValue(signer)))
+				}
+			}
+			cases = append(cases, tc)
+		}
+
+		c := field.Constraints
+		if c.Required {
+			add(ConstraintRequired, "", "ErrInvalidRequest")
+		}
+
+		// Numbers can't be lower or greater than the bounds of their type
+		isNumber := field.constraintKind() == kindNumber
+		typeMin, typeMax := field.numberRange()
+		if c.Min != nil {
+			switch {
+			case isNumber && *c.Min > typeMin:
+				add(fmt.Sprintf("%s=%d", ConstraintMin, *c.Min), field.numberValue(*c.Min-1), "ErrInvalidRequest")
+			case !isNumber && *c.Min > 0:
+				add(fmt.Sprintf("%s=%d", ConstraintMin, *c.Min), field.lengthValue(*c.Min-1), "ErrInvalidRequest")
+			}
+		}
+		switch {
+		case c.Max != nil && isNumber && *c.Max < typeMax:
+			add(fmt.Sprintf("%s=%d", ConstraintMax, *c.Max), field.numberValue(*c.Max+1), "ErrInvalidRequest")
+		case c.Max != nil && !isNumber:
+			add(fmt.Sprintf("%s=%d", ConstraintMax, *c.Max), field.lengthValue(*c.Max+1), "ErrInvalidRequest")
+		}
+		if c.Unique {
+			elem := field.listValue(1)
+			elem = elem[strings.Index(elem, "{")+1 : len(elem)-1]
+			add(ConstraintUnique, fmt.Sprintf("%s{%s, %s}", field.DataType(), elem, elem), "ErrInvalidRequest")
+		}
+		if c.Signer {
+			add(ConstraintSigner, "sample.AccAddress()", "ErrUnauthorized")
+		}
+	}
+	return cases
+}
+
+// ConstraintTestImports returns the Go imports required by the
+// values of the constrained fields in the message tests.
+func (f Fields) ConstraintTestImports() []datatype.GoImport {
+	var useSDK, useTime bool
+	for _, field := range f {
+		if !field.HasConstraints() {
+			continue
+		}
+		switch {
+		case field.DatatypeName == datatype.Timestamp:
+			useTime = true
+		case field.DatatypeName == datatype.Coin, field.DatatypeName == datatype.Decimal, field.isCoins():
+			useSDK = true
+		}
+	}
+
+	imports := make([]datatype.GoImport, 0)
+	if useTime {
+		imports = append(imports, datatype.GoImport{Name: "time"})
+	}
+	if useSDK {
+		imports = append(imports, datatype.GoImport{Name: "github.com/cosmos/cosmos-sdk/types", Alias: "sdk"})
+	}
+	return imports
+}
@@ -0,0 +1,220 @@
+package field
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+func TestFieldValidateBasic(t *testing.T) {
+	signer, err := multiformatname.NewName("creator")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		field string
+		want  string
+	}{
+		{
+			name:  "no constraints",
+			field: "title",
+			want:  "",
+		},
+		{
+			name:  "required text with max length",
+			field: "title:string:required,max=64",
+			want: `if len(msg.Title) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "title is required")
+	}
+	if len(msg.Title) > 64 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "title must have at most 64 characters")
+	}`,
+		},
+		{
+			name:  "min number",
+			field: "amount:uint:min=1",
+			want: `if msg.Amount < 1 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "amount must be at least 1")
+	}`,
+		},
+		{
+			name:  "vacuous min length",
+			field: "tags:strings:min=0",
+			want:  "",
+		},
+		{
+			name:  "signer",
+			field: "owner:address:signer",
+			want: `if msg.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+		}
+	}
+	if msg.Owner != msg.Creator {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "owner must be the creator")
+	}`,
+		},
+		{
+			name:  "unique",
+			field: "tags:strings:unique",
+			want: `{
+		seen := make(map[string]struct{})
+		for _, v := range msg.Tags {
+			if _, ok := seen[v]; ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tags must not contain duplicates, found %v", v)
+			}
+			seen[v] = struct{}{}
+		}
+	}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			fields, err := ParseFields([]string{tt.field}, noCheck)
+			require.NoError(t, err)
+
+			// Act
+			got := fields[0].ValidateBasic(signer)
+
+			// Assert
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFieldsConstraintTestCases(t *testing.T) {
+	// Arrange
+	fields, err := ParseFields([]string{
+		"title:string:required,max=2",
+		"amount:int:min=-1",
+		"owner:address:signer",
+		"body",
+	}, noCheck)
+	require.NoError(t, err)
+
+	// Act
+	values := fields.ValidTestValues("signer")
+	cases := fields.ConstraintTestCases("signer")
+
+	// Assert
+	require.Equal(t, []string{`Title: "a"`, "Amount: -1", "Owner: signer"}, values)
+	require.Equal(t, []ConstraintTestCase{
+		{
+			Name:   "invalid title (required)",
+			Values: []string{"Amount: -1", "Owner: signer"},
+			Err:    "sdkerrors.ErrInvalidRequest",
+		},
+		{
+			Name:   "invalid title (max=2)",
+			Values: []string{`Title: "aaa"`, "Amount: -1", "Owner: signer"},
+			Err:    "sdkerrors.ErrInvalidRequest",
+		},
+		{
+			Name:   "invalid amount (min=-1)",
+			Values: []string{`Title: "a"`, "Amount: -2", "Owner: signer"},
+			Err:    "sdkerrors.ErrInvalidRequest",
+		},
+		{
+			Name:   "invalid owner (signer)",
+			Values: []string{`Title: "a"`, "Amount: -1", "Owner: sample.AccAddress()"},
+			Err:    "sdkerrors.ErrUnauthorized",
+		},
+	}, cases)
+}
+
+func TestFieldConstrainedTestValue(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{field: "title:string:required,max=2", want: "a"},
+		{field: "title:string:max=64", want: "xyz"},
+		{field: "tags:strings:min=3", want: "a0,a1,a2"},
+		{field: "amount:int:min=-5,max=5", want: "0"},
+		{field: "amount:int:min=-5,max=-2", want: "-5"},
+		{field: "price:decimal:min=-2,max=5", want: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			// Arrange
+			fields, err := ParseFields([]string{tt.field}, noCheck)
+			require.NoError(t, err)
+
+			// Act
+			got := fields[0].DefaultTestValue()
+
+			// Assert
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFieldsConstraintTestCasesTypeBounds(t *testing.T) {
+	// Arrange
+	fields, err := ParseFields([]string{
+		"amount:int:min=-2147483648,max=2147483647",
+		"count:uint:max=9223372036854775807",
+	}, noCheck)
+	require.NoError(t, err)
+
+	// Act
+	cases := fields.ConstraintTestCases("signer")
+
+	// Assert
+	require.Empty(t, cases)
+}
+
+func TestFieldsConstraintTestCasesMaxLength(t *testing.T) {
+	// Arrange
+	fields, err := ParseFields([]string{
+		fmt.Sprintf("title:string:max=%d", MaxConstraintLength),
+		fmt.Sprintf("tags:strings:min=%d", MaxConstraintLength),
+	}, noCheck)
+	require.NoError(t, err)
+
+	// Act
+	cases := fields.ConstraintTestCases("signer")
+
+	// Assert
+	require.Len(t, cases, 2)
+	require.Contains(t, cases[0].Values[0], strings.Repeat("a", MaxConstraintLength+1))
+	require.Equal(t, MaxConstraintLength-1, strings.Count(cases[1].Values[1], `"a`))
+}
+
+func TestFieldSimValue(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{field: "title", want: ""},
+		{field: "title:string:required,max=2", want: "simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 3))"},
+		{field: "amount:int:min=-5", want: "int32(simtypes.RandIntBetween(r, -5, 96))"},
+		{field: "amount:int:min=2147483600", want: "int32(simtypes.RandIntBetween(r, 2147483600, 2147483648))"},
+		{
+			field: "count:uint:max=9223372036854775807",
+			want:  "uint64(simtypes.RandIntBetween(r, 0, 9223372036854775807))",
+		},
+		{
+			field: "price:decimal:min=-9223372036854775808,max=9223372036854775807",
+			want:  "sdk.NewDec(int64(simtypes.RandIntBetween(r, -9223372036854775808, -1)))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			// Arrange
+			fields, err := ParseFields([]string{tt.field}, noCheck)
+			require.NoError(t, err)
+
+			// Act
+			got := fields[0].SimValue("simAccount.Address.String()")
+
+			// Assert
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+// DataAddress account address data type definition
+var DataAddress = DataType{
+	DataType:          func(string) string { return "string" },
+	DefaultTestValue:  "cosmos1adn9gxjmrc3hrsdx5zpc9sj2ra7kgqkmphf8yw",
+	ValueLoop:         "strconv.Itoa(i)",
+	ValueIndex:        "strconv.Itoa(0)",
+	ValueInvalidIndex: "strconv.Itoa(100000)",
+	ProtoType: func(_, name string, index int) string {
+		return fmt.Sprintf("string %s = %d", name, index)
+	},
+	GenesisArgs: func(name multiformatname.Name, value int) string {
+		return fmt.Sprintf("%s: \"%d\",\n", name.UpperCamel, value)
+	},
+	CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+		return fmt.Sprintf("%s%s := args[%d]", prefix, name.UpperCamel, argIndex)
+	},
+	ToBytes: func(name string) string {
+		return fmt.Sprintf("%[1]vBytes := []byte(%[1]v)", name)
+	},
+	ToString: func(name string) string {
+		return name
+	},
+	ValidateBasic: func(name multiformatname.Name) string {
+		return fmt.Sprintf(`if msg.%[1]v != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.%[1]v); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid %[2]v address (%%s)", err)
+		}
+	}`, name.UpperCamel, name.LowerCamel)
+	},
+}
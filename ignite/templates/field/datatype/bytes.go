@@ -0,0 +1,27 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+// DataBytes bytes data type definition
+var DataBytes = DataType{
+	DataType:         func(string) string { return "[]byte" },
+	DefaultTestValue: "0a0b0c",
+	ProtoType: func(_, name string, index int) string {
+		return fmt.Sprintf("bytes %s = %d", name, index)
+	},
+	GenesisArgs: func(name multiformatname.Name, value int) string {
+		return fmt.Sprintf("%s: []byte{%d},\n", name.UpperCamel, value)
+	},
+	CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+		return fmt.Sprintf(`%s%s, err := hex.DecodeString(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+	},
+	GoCLIImports: []GoImport{{Name: "encoding/hex"}},
+	NonIndex:     true,
+}
@@ -0,0 +1,74 @@
+package datatype
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+const (
+	// EnumSeparator separates the enum type name from the enum values, like in "enum=A|B|C"
+	EnumSeparator = "="
+	// EnumValueSeparator separates the enum values
+	EnumValueSeparator = "|"
+)
+
+// DataEnum enum data type definition.
+// The datatype of the enum fields is the name of the proto enum.
+var DataEnum = DataType{
+	DataType: func(datatype string) string { return datatype },
+	ProtoType: func(datatype, name string, index int) string {
+		return fmt.Sprintf("%s %s = %d", datatype, name, index)
+	},
+	GenesisArgs: func(multiformatname.Name, int) string { return "" },
+	CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+		return fmt.Sprintf(`%[1]vValue%[2]v, ok := types.%[3]v_value["%[4]v_"+strings.ToUpper(args[%[5]v])]
+					if !ok {
+						return fmt.Errorf("invalid %[6]v value %%s", args[%[5]v])
+					}
+					%[1]v%[2]v := types.%[3]v(%[1]vValue%[2]v)`,
+			prefix, name.UpperCamel, datatype, EnumValuePrefix(datatype), argIndex, name.LowerCamel)
+	},
+	GoCLIImports: []GoImport{{Name: "fmt"}, {Name: "strings"}},
+	NonIndex:     true,
+}
+
+// IsEnum returns true if the type name defines an enum with its values, like "enum=A|B|C".
+func IsEnum(typeName Name) bool {
+	return strings.HasPrefix(string(typeName), string(Enum)+EnumSeparator)
+}
+
+// ParseEnumValues returns the values of an enum type name, like "enum=A|B|C".
+func ParseEnumValues(typeName Name) ([]multiformatname.Name, error) {
+	_, spec, _ := strings.Cut(string(typeName), EnumSeparator)
+
+	var (
+		values []multiformatname.Name
+		exist  = make(map[string]struct{})
+	)
+	for _, v := range strings.Split(spec, EnumValueSeparator) {
+		value, err := multiformatname.NewName(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid enum value %q: %w", v, err)
+		}
+
+		if _, ok := exist[value.Snake]; ok {
+			return nil, fmt.Errorf("the enum value %s is duplicated", v)
+		}
+		exist[value.Snake] = struct{}{}
+
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// EnumValuePrefix returns the prefix of the proto enum value names of an enum,
+// like "POST_STATUS" for the "PostStatus" enum.
+func EnumValuePrefix(enumName string) string {
+	name, err := multiformatname.NewName(enumName)
+	if err != nil {
+		return strings.ToUpper(enumName)
+	}
+	return strings.ToUpper(name.Snake)
+}
@@ -0,0 +1,51 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/ignite/cli/ignite/pkg/multiformatname"
+)
+
+var (
+	// DataTimestamp timestamp data type definition
+	DataTimestamp = DataType{
+		DataType:         func(string) string { return "time.Time" },
+		DefaultTestValue: "2023-01-01T00:00:00Z",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("google.protobuf.Timestamp %s = %d [(gogoproto.stdtime) = true, (gogoproto.nullable) = false]",
+				name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := time.Parse(time.RFC3339, args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		GoCLIImports:  []GoImport{{Name: "time"}},
+		GoTypeImports: []GoImport{{Name: "time"}},
+		ProtoImports:  []string{"gogoproto/gogo.proto", "google/protobuf/timestamp.proto"},
+		NonIndex:      true,
+	}
+
+	// DataDuration duration data type definition
+	DataDuration = DataType{
+		DataType:         func(string) string { return "time.Duration" },
+		DefaultTestValue: "1h",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("google.protobuf.Duration %s = %d [(gogoproto.stdduration) = true, (gogoproto.nullable) = false]",
+				name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := time.ParseDuration(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		GoCLIImports:  []GoImport{{Name: "time"}},
+		GoTypeImports: []GoImport{{Name: "time"}},
+		ProtoImports:  []string{"gogoproto/gogo.proto", "google/protobuf/duration.proto"},
+		NonIndex:      true,
+	}
+)
@@ -28,6 +28,18 @@ const (
 	Coin Name = "coin"
 	// Coins represents the coin array type name
 	Coins Name = "array.coin"
+	// Address represents the account address type name
+	Address Name = "address"
+	// Decimal represents the decimal type name
+	Decimal Name = "decimal"
+	// Bytes represents the bytes type name
+	Bytes Name = "bytes"
+	// Timestamp represents the timestamp type name
+	Timestamp Name = "timestamp"
+	// Duration represents the duration type name
+	Duration Name = "duration"
+	// Enum represents the enum type name
+	Enum Name = "enum"
 	// Custom represents the custom type name
 	Custom Name = Name(TypeCustom)
 
@@ -59,6 +71,12 @@ var SupportedTypes = map[Name]DataType{
 	Coin:             DataCoin,
 	Coins:            DataCoinSlice,
 	CoinSliceAlias:   DataCoinSlice,
+	Address:          DataAddress,
+	Decimal:          DataDecimal,
+	Bytes:            DataBytes,
+	Timestamp:        DataTimestamp,
+	Duration:         DataDuration,
+	Enum:             DataEnum,
 	Custom:           DataCustom,
 }
 
@@ -72,6 +90,7 @@ type DataType struct {
 	GenesisArgs       func(name multiformatname.Name, value int) string
 	ProtoImports      []string
 	GoCLIImports      []GoImport
+	GoTypeImports     []GoImport
 	DefaultTestValue  string
 	ValueLoop         string
 	ValueIndex        string
@@ -79,6 +98,7 @@ type DataType struct {
 	ToBytes           func(name string) string
 	ToString          func(name string) string
 	CLIArgs           func(name multiformatname.Name, datatype, prefix string, argIndex int) string
+	ValidateBasic     func(name multiformatname.Name) string
 	NonIndex          bool
 }
 
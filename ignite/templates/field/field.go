@@ -3,6 +3,8 @@ package field
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/ignite/cli/ignite/pkg/multiformatname"
 	"github.com/ignite/cli/ignite/templates/field/datatype"
@@ -14,6 +16,8 @@ type Field struct {
 	Name         multiformatname.Name
 	DatatypeName datatype.Name
 	Datatype     string
+	EnumValues   []multiformatname.Name
+	Constraints  Constraints
 }
 
 // DataType returns the field Datatype
@@ -45,9 +49,26 @@ func (f Field) DefaultTestValue() string {
 	if !ok {
 		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
 	}
+	// The first enum value is used because enum values depend on the field
+	if len(f.EnumValues) > 0 {
+		return f.EnumValues[0].Snake
+	}
+	if f.HasConstraints() {
+		return f.constrainedTestValue(dt.DefaultTestValue)
+	}
 	return dt.DefaultTestValue
 }
 
+// CLITestArg returns the Go value of the CLI argument of the field in the generated
+// tests. The addresses are replaced by the address of the test validator, which
+// uses the address prefix of the chain.
+func (f Field) CLITestArg(validatorAddress string) string {
+	if f.DatatypeName == datatype.Address {
+		return validatorAddress
+	}
+	return strconv.Quote(f.DefaultTestValue())
+}
+
 // ValueLoop returns the Datatype value for loop iteration
 func (f Field) ValueLoop() string {
 	dt, ok := datatype.SupportedTypes[f.DatatypeName]
@@ -135,6 +156,42 @@ func (f Field) GoCLIImports() []datatype.GoImport {
 	return dt.GoCLIImports
 }
 
+// GoTypeImports returns the Datatype imports required by the Go type
+func (f Field) GoTypeImports() []datatype.GoImport {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	return dt.GoTypeImports
+}
+
+// ValidateBasic returns the Datatype validation and the constraint checks for the message ValidateBasic method
+func (f Field) ValidateBasic(signer multiformatname.Name) string {
+	dt, ok := datatype.SupportedTypes[f.DatatypeName]
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	var checks []string
+	if dt.ValidateBasic != nil {
+		checks = append(checks, dt.ValidateBasic(f.Name))
+	}
+	checks = append(checks, f.constraintChecks(signer)...)
+	return strings.Join(checks, "\n\t")
+}
+
+// ProtoEnum returns the proto enum definition of an enum field
+func (f Field) ProtoEnum() string {
+	if f.DatatypeName != datatype.Enum {
+		return ""
+	}
+	prefix := datatype.EnumValuePrefix(f.Datatype)
+	enum := fmt.Sprintf("enum %s {\n", f.Datatype)
+	for i, value := range f.EnumValues {
+		enum += fmt.Sprintf("  %s_%s = %d;\n", prefix, strings.ToUpper(value.Snake), i)
+	}
+	return enum + "}"
+}
+
 // ProtoImports return the Datatype imports for proto files
 func (f Field) ProtoImports() []string {
 	dt, ok := datatype.SupportedTypes[f.DatatypeName]
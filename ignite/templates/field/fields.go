@@ -42,6 +42,55 @@ func (f Fields) ProtoImports() []string {
 	return allImports
 }
 
+// GoTypeImports return all go imports required by the field types
+func (f Fields) GoTypeImports() []datatype.GoImport {
+	allImports := make([]datatype.GoImport, 0)
+	exist := make(map[string]struct{})
+	for _, fields := range f {
+		for _, goImport := range fields.GoTypeImports() {
+			if _, ok := exist[goImport.Name]; ok {
+				continue
+			}
+			exist[goImport.Name] = struct{}{}
+			allImports = append(allImports, goImport)
+		}
+	}
+	return allImports
+}
+
+// ProtoEnums return the proto enum definitions of the enum fields
+func (f Fields) ProtoEnums() []string {
+	enums := make([]string, 0)
+	for _, field := range f {
+		if enum := field.ProtoEnum(); enum != "" {
+			enums = append(enums, enum)
+		}
+	}
+	return enums
+}
+
+// SetEnumPrefix names the proto enums of the fields after the type or the message
+// that declares them, like "PostStatus" for the status field of a post, so the
+// enums of the different components of a module don't clash.
+func (f Fields) SetEnumPrefix(prefix string) {
+	for i := range f {
+		if f[i].DatatypeName == datatype.Enum {
+			f[i].Datatype = prefix + f[i].Name.UpperCamel
+		}
+	}
+}
+
+// EnumNames returns the names of the proto enums of the enum fields.
+func (f Fields) EnumNames() []string {
+	names := make([]string, 0)
+	for _, field := range f {
+		if field.DatatypeName == datatype.Enum {
+			names = append(names, field.Datatype)
+		}
+	}
+	return names
+}
+
 // String return all inline fields args for command usage
 func (f Fields) String() string {
 	args := ""
@@ -8,30 +8,39 @@ import (
 	"github.com/ignite/cli/ignite/templates/field/datatype"
 )
 
-// validateField validates the field Name and type, and checks the name is not forbidden by Ignite CLI
-func validateField(field string, isForbiddenField func(string) error) (multiformatname.Name, datatype.Name, error) {
+// validateField validates the field Name and type, and checks the name is not forbidden by Ignite CLI.
+// The constraints of the field are returned without being parsed.
+func validateField(field string, isForbiddenField func(string) error) (multiformatname.Name, datatype.Name, string, error) {
 	fieldSplit := strings.Split(field, datatype.Separator)
-	if len(fieldSplit) > 2 {
-		return multiformatname.Name{}, "", fmt.Errorf("invalid field format: %s, should be 'Name' or 'Name:type'", field)
+	if len(fieldSplit) > 3 {
+		return multiformatname.Name{}, "", "", fmt.Errorf(
+			"invalid field format: %s, should be 'Name', 'Name:type' or 'Name:type:constraints'",
+			field,
+		)
 	}
 
 	name, err := multiformatname.NewName(fieldSplit[0])
 	if err != nil {
-		return name, "", err
+		return name, "", "", err
 	}
 
 	// Ensure the field Name is not a Go reserved Name, it would generate an incorrect code
 	if err := isForbiddenField(name.LowerCamel); err != nil {
-		return name, "", fmt.Errorf("%s can't be used as a field Name: %w", name, err)
+		return name, "", "", fmt.Errorf("%s can't be used as a field Name: %w", name, err)
 	}
 
 	// Check if the object has an explicit type. The default is a string
 	dataTypeName := datatype.String
-	isTypeSpecified := len(fieldSplit) == 2
+	isTypeSpecified := len(fieldSplit) >= 2
 	if isTypeSpecified {
 		dataTypeName = datatype.Name(fieldSplit[1])
 	}
-	return name, dataTypeName, nil
+
+	var constraints string
+	if len(fieldSplit) == 3 {
+		constraints = fieldSplit[2]
+	}
+	return name, dataTypeName, constraints, nil
 }
 
 // ParseFields parses the provided fields, analyses the types
@@ -51,7 +60,7 @@ func ParseFields(
 
 	var parsedFields Fields
 	for _, field := range fields {
-		name, datatypeName, err := validateField(field, isForbiddenField)
+		name, datatypeName, constraints, err := validateField(field, isForbiddenField)
 		if err != nil {
 			return parsedFields, err
 		}
@@ -62,20 +71,38 @@ func ParseFields(
 		}
 		existingFields[name.LowerCamel] = struct{}{}
 
-		// Check if is a static type
-		if _, ok := datatype.SupportedTypes[datatypeName]; ok {
-			parsedFields = append(parsedFields, Field{
-				Name:         name,
-				DatatypeName: datatypeName,
-			})
-			continue
+		if datatypeName == datatype.Enum {
+			return parsedFields, fmt.Errorf("the enum field %s has no values, should be 'Name:enum=A|B'", name.Original)
 		}
 
-		parsedFields = append(parsedFields, Field{
+		f := Field{
 			Name:         name,
-			Datatype:     string(datatypeName),
-			DatatypeName: datatype.TypeCustom,
-		})
+			DatatypeName: datatypeName,
+		}
+
+		switch _, ok := datatype.SupportedTypes[datatypeName]; {
+		case datatype.IsEnum(datatypeName):
+			// The proto enum is named after the field, the components prefix
+			// it with their name with Fields.SetEnumPrefix
+			values, err := datatype.ParseEnumValues(datatypeName)
+			if err != nil {
+				return parsedFields, err
+			}
+			f.DatatypeName = datatype.Enum
+			f.Datatype = name.UpperCamel
+			f.EnumValues = values
+		case !ok:
+			f.DatatypeName = datatype.TypeCustom
+			f.Datatype = string(datatypeName)
+		}
+
+		if constraints != "" {
+			if f.Constraints, err = parseConstraints(f, constraints); err != nil {
+				return parsedFields, err
+			}
+		}
+
+		parsedFields = append(parsedFields, f)
 	}
 	return parsedFields, nil
 }
@@ -35,6 +35,60 @@ func TestForbiddenParseFields(t *testing.T) {
 	// invalid format
 	_, err = ParseFields([]string{"foo:int:int"}, alwaysInvalid)
 	require.Error(t, err)
+
+	// enum without values
+	_, err = ParseFields([]string{"foo:enum"}, noCheck)
+	require.Error(t, err)
+
+	// invalid enum value
+	_, err = ParseFields([]string{"foo:enum=A|1B"}, noCheck)
+	require.Error(t, err)
+
+	// duplicated enum value
+	_, err = ParseFields([]string{"foo:enum=A|a"}, noCheck)
+	require.Error(t, err)
+
+	// unknown constraint
+	_, err = ParseFields([]string{"foo:string:invalid"}, noCheck)
+	require.Error(t, err)
+
+	// constraint not supported by the type
+	_, err = ParseFields([]string{"foo:bool:required"}, noCheck)
+	require.Error(t, err)
+
+	// constraint without value
+	_, err = ParseFields([]string{"foo:string:max"}, noCheck)
+	require.Error(t, err)
+
+	// negative length
+	_, err = ParseFields([]string{"foo:string:min=-1"}, noCheck)
+	require.Error(t, err)
+
+	// bounds out of the range of the int32 type
+	_, err = ParseFields([]string{"foo:int:max=3000000000"}, noCheck)
+	require.Error(t, err)
+	_, err = ParseFields([]string{"foo:int:min=-3000000000"}, noCheck)
+	require.Error(t, err)
+
+	// bound out of the range of the int64 values
+	_, err = ParseFields([]string{"foo:uint:max=18446744073709551615"}, noCheck)
+	require.Error(t, err)
+
+	// lengths greater than the maximum length
+	_, err = ParseFields([]string{"title:string:max=9223372036854775807"}, noCheck)
+	require.Error(t, err)
+	_, err = ParseFields([]string{"tags:strings:min=9223372036854775807"}, noCheck)
+	require.Error(t, err)
+	_, err = ParseFields([]string{"data:bytes:min=1000000000"}, noCheck)
+	require.Error(t, err)
+
+	// min greater than max
+	_, err = ParseFields([]string{"foo:int:min=2,max=1"}, noCheck)
+	require.Error(t, err)
+
+	// invalid format
+	_, err = ParseFields([]string{"foo:string:required:max=1"}, noCheck)
+	require.Error(t, err)
 }
 
 func TestParseFields1(t *testing.T) {
@@ -46,6 +100,13 @@ func TestParseFields1(t *testing.T) {
 	require.NoError(t, err)
 	name4, err := multiformatname.NewName("foo_foo")
 	require.NoError(t, err)
+	delay, err := multiformatname.NewName("delay")
+	require.NoError(t, err)
+	valueA, err := multiformatname.NewName("A")
+	require.NoError(t, err)
+	valueInProgress, err := multiformatname.NewName("inProgress")
+	require.NoError(t, err)
+	minValue, maxLength := int64(1), int64(64)
 
 	tests := []struct {
 		name   string
@@ -182,6 +243,83 @@ func TestParseFields1(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test address, decimal, bytes and time types",
+			fields: []string{
+				name1.Original + ":address",
+				name2.Original + ":decimal",
+				name3.Original + ":bytes",
+				name4.Original + ":timestamp",
+				"delay:duration",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Address,
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Decimal,
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.Bytes,
+				},
+				{
+					Name:         name4,
+					DatatypeName: datatype.Timestamp,
+				},
+				{
+					Name:         delay,
+					DatatypeName: datatype.Duration,
+				},
+			},
+		},
+		{
+			name: "test enum types",
+			fields: []string{
+				name2.Original + ":enum=A|inProgress",
+			},
+			want: Fields{
+				{
+					Name:         name2,
+					DatatypeName: datatype.Enum,
+					Datatype:     "FooBar",
+					EnumValues:   []multiformatname.Name{valueA, valueInProgress},
+				},
+			},
+		},
+		{
+			name: "test constraints",
+			fields: []string{
+				name1.Original + ":string:required,max=64",
+				name2.Original + ":uint:min=1",
+				name3.Original + ":address:signer",
+				name4.Original + ":strings:unique",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.String,
+					Constraints:  Constraints{Required: true, Max: &maxLength},
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Uint,
+					Constraints:  Constraints{Min: &minValue},
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.Address,
+					Constraints:  Constraints{Signer: true},
+				},
+				{
+					Name:         name4,
+					DatatypeName: datatype.StringSliceAlias,
+					Constraints:  Constraints{Unique: true},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -195,3 +333,64 @@ func TestParseFields1(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldProtoEnum(t *testing.T) {
+	// Arrange
+	fields, err := ParseFields([]string{"orderStatus:enum=open|inProgress", "foo"}, noCheck)
+	require.NoError(t, err)
+
+	// Act
+	enums := fields.ProtoEnums()
+
+	// Assert
+	require.Equal(t, []string{`enum OrderStatus {
+  ORDER_STATUS_OPEN = 0;
+  ORDER_STATUS_IN_PROGRESS = 1;
+}`}, enums)
+	require.Equal(t, "OrderStatus", fields[0].DataType())
+	require.Equal(t, "OrderStatus orderStatus = 2", fields[0].ProtoType(2))
+	require.Equal(t, "open", fields[0].DefaultTestValue())
+}
+
+func TestFieldsSetEnumPrefix(t *testing.T) {
+	// Arrange
+	fields, err := ParseFields([]string{"status:enum=open|inProgress", "foo"}, noCheck)
+	require.NoError(t, err)
+
+	// Act
+	fields.SetEnumPrefix("Order")
+
+	// Assert
+	require.Equal(t, []string{`enum OrderStatus {
+  ORDER_STATUS_OPEN = 0;
+  ORDER_STATUS_IN_PROGRESS = 1;
+}`}, fields.ProtoEnums())
+	require.Equal(t, []string{"OrderStatus"}, fields.EnumNames())
+	require.Equal(t, "OrderStatus status = 2", fields[0].ProtoType(2))
+	require.Equal(t, "string", fields[1].DataType())
+}
+
+func TestFieldCLITestArg(t *testing.T) {
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{field: "title", want: `"xyz"`},
+		{field: "owner:address", want: "val.Address.String()"},
+		{field: "owner:address:signer", want: "val.Address.String()"},
+		{field: "status:enum=open|closed", want: `"open"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			// Arrange
+			fields, err := ParseFields([]string{tt.field}, noCheck)
+			require.NoError(t, err)
+
+			// Act
+			got := fields[0].CLITestArg("val.Address.String()")
+
+			// Assert
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 // ExtendPlushContext sets available field helpers on the provided context.
 func ExtendPlushContext(ctx *plush.Context) {
 	ctx.Set("mergeGoImports", mergeGoImports)
+	ctx.Set("mergeGoTypeImports", mergeGoTypeImports)
 	ctx.Set("mergeProtoImports", mergeProtoImports)
 	ctx.Set("mergeCustomImports", mergeCustomImports)
 	ctx.Set("title", xstrings.Title)
@@ -49,6 +50,21 @@ func mergeGoImports(fields ...field.Fields) []datatype.GoImport {
 	return allImports
 }
 
+func mergeGoTypeImports(fields ...field.Fields) []datatype.GoImport {
+	allImports := make([]datatype.GoImport, 0)
+	exist := make(map[string]struct{})
+	for _, fields := range fields {
+		for _, goImport := range fields.GoTypeImports() {
+			if _, ok := exist[goImport.Name]; ok {
+				continue
+			}
+			exist[goImport.Name] = struct{}{}
+			allImports = append(allImports, goImport)
+		}
+	}
+	return allImports
+}
+
 func mergeProtoImports(fields ...field.Fields) []string {
 	allImports := make([]string, 0)
 	exist := make(map[string]struct{})
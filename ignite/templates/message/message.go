@@ -114,7 +114,13 @@ func protoTxMessageModify(replacer placeholder.Replacer, opts *Options) genny.Ru
 			resFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
 		}
 
-		template := `message Msg%[2]v {
+		// Enums are defined before the messages that use them
+		var enums string
+		for _, enum := range append(opts.Fields.ProtoEnums(), opts.ResFields.ProtoEnums()...) {
+			enums += enum + "\n\n"
+		}
+
+		template := `%[6]vmessage Msg%[2]v {
   string %[5]v = 1;
 %[3]v}
 
@@ -128,6 +134,7 @@ message Msg%[2]vResponse {
 			msgFields,
 			resFields,
 			opts.MsgSigner.LowerCamel,
+			enums,
 		)
 		content := replacer.Replace(f.String(), PlaceholderProtoTxMessage, replacement)
 
@@ -49,7 +49,7 @@ func NewGenerator(replacer placeholder.Replacer, opts *typed.Options) (*genny.Ge
 		)
 	)
 
-	g.RunFn(typesKeyModify(opts))
+	g.RunFn(typesKeyModify(replacer, opts))
 	g.RunFn(protoRPCModify(replacer, opts))
 	g.RunFn(clientCliQueryModify(replacer, opts))
 	g.RunFn(genesisProtoModify(replacer, opts))
@@ -79,18 +79,18 @@ func NewGenerator(replacer placeholder.Replacer, opts *typed.Options) (*genny.Ge
 	return g, typed.Box(componentTemplate, opts, g)
 }
 
-func typesKeyModify(opts *typed.Options) genny.RunFn {
+func typesKeyModify(replacer placeholder.Replacer, opts *typed.Options) genny.RunFn {
 	return func(r *genny.Runner) error {
 		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/keys.go")
 		f, err := r.Disk.Find(path)
 		if err != nil {
 			return err
 		}
-		content := f.String() + fmt.Sprintf(`
+		content := replacer.Append(f.String(), fmt.Sprintf(`
 const (
-	%[1]vKey= "%[1]v/value/"
+	%[1]vKey = "%[1]v/value/"
 )
-`, opts.TypeName.UpperCamel)
+`, opts.TypeName.UpperCamel))
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
 	}
@@ -0,0 +1,64 @@
+//go:build !relayer
+
+package other_components_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/ignite/pkg/cmdrunner/step"
+	envtest "github.com/ignite/cli/integration"
+)
+
+func TestRemoveScaffoldedComponents(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		app  = env.Scaffold("github.com/test/blog")
+		path = app.SourcePath()
+	)
+
+	scaffold := func(msg string, args ...string) {
+		env.Must(env.Exec(msg,
+			step.NewSteps(step.New(
+				step.Exec(envtest.IgniteApp, append([]string{"s"}, append(args, "--yes")...)...),
+				step.Workdir(path),
+			)),
+		))
+	}
+
+	scaffold("create a module", "module", "foo")
+	scaffold("create a list", "list", "post", "title", "body")
+	scaffold("create another list", "list", "comment", "body")
+	scaffold("create a message", "message", "do-foo", "text", "--module", "foo")
+	scaffold("create a query", "query", "bar", "text", "-r", "foo,bar:int")
+
+	scaffold("remove the list", "remove", "type", "post")
+	require.NoFileExists(t, filepath.Join(path, "x/blog/keeper/post.go"))
+	require.NoFileExists(t, filepath.Join(path, "x/blog/types/post.pb.go"))
+
+	// The code shared with the other list is kept
+	keys, err := os.ReadFile(filepath.Join(path, "x/blog/types/keys.go"))
+	require.NoError(t, err)
+	require.NotContains(t, string(keys), "PostKey")
+	require.Contains(t, string(keys), "CommentKey")
+	codec, err := os.ReadFile(filepath.Join(path, "x/blog/types/codec.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(codec), `sdk "github.com/cosmos/cosmos-sdk/types"`)
+
+	scaffold("remove the query", "remove", "query", "bar")
+	scaffold("remove the module", "remove", "module", "foo")
+	require.NoDirExists(t, filepath.Join(path, "x/foo"))
+
+	env.Must(env.Exec("remove a component that wasn't scaffolded",
+		step.NewSteps(step.New(
+			step.Exec(envtest.IgniteApp, "s", "remove", "type", "post", "--yes"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	app.EnsureSteady()
+}